@@ -0,0 +1,35 @@
+package starr
+
+import (
+	"sort"
+	"time"
+)
+
+/* This file contains helpers for the backup methods in each app package. */
+
+// BackupCommand is the name of the command that creates a backup in every app.
+const BackupCommand = "Backup"
+
+// BackupRestoreField is the multipart form field name used when uploading a backup to restore.
+const BackupRestoreField = "restore"
+
+// BackupsToPrune returns the backup files that should be deleted to satisfy a retention policy.
+// The newest keep files are retained; 0 disables the count limit. Any file older than maxAge
+// is pruned, even if it is within the newest keep files; 0 disables the age limit.
+// The input list is not modified.
+func BackupsToPrune(backups []*BackupFile, keep int, maxAge time.Duration) []*BackupFile {
+	sorted := make([]*BackupFile, len(backups))
+	copy(sorted, backups)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.After(sorted[j].Time) })
+
+	prune := []*BackupFile{}
+	cutoff := time.Now().Add(-maxAge)
+
+	for idx, backup := range sorted {
+		if (keep > 0 && idx >= keep) || (maxAge > 0 && backup.Time.Before(cutoff)) {
+			prune = append(prune, backup)
+		}
+	}
+
+	return prune
+}
@@ -0,0 +1,36 @@
+package starr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupsToPrune(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	backups := []*starr.BackupFile{
+		{ID: 1, Time: now.Add(-72 * time.Hour)},
+		{ID: 2, Time: now.Add(-time.Hour)},
+		{ID: 3, Time: now.Add(-48 * time.Hour)},
+		{ID: 4, Time: now.Add(-24 * time.Hour)},
+	}
+
+	ids := func(files []*starr.BackupFile) []int64 {
+		output := []int64{}
+		for _, file := range files {
+			output = append(output, file.ID)
+		}
+
+		return output
+	}
+
+	assert.Equal(t, []int64{}, ids(starr.BackupsToPrune(backups, 0, 0)), "no limits must prune nothing")
+	assert.Equal(t, []int64{3, 1}, ids(starr.BackupsToPrune(backups, 2, 0)), "the oldest two must be pruned")
+	assert.Equal(t, []int64{1}, ids(starr.BackupsToPrune(backups, 0, 60*time.Hour)), "only ID 1 is too old")
+	assert.Equal(t, []int64{3, 1}, ids(starr.BackupsToPrune(backups, 3, 36*time.Hour)), "age applies within keep")
+	assert.Equal(t, int64(1), backups[0].ID, "the input list must not be modified")
+}
@@ -0,0 +1,61 @@
+package starr
+
+import (
+	"fmt"
+	"time"
+)
+
+/* This file contains shared types for the command methods in each app package. */
+
+// DefaultCommandPollInterval is used by the WaitForCommand methods when no interval is provided.
+const DefaultCommandPollInterval = time.Second
+
+// These are the statuses a command may have in every app.
+const (
+	CommandQueued    = "queued"
+	CommandStarted   = "started"
+	CommandCompleted = "completed"
+	CommandFailed    = "failed"
+	CommandAborted   = "aborted"
+	CommandCancelled = "cancelled"
+	CommandOrphaned  = "orphaned"
+)
+
+// ErrCommandFailed matches ANY CommandError when using errors.Is.
+// Use errors.As if you need the command data.
+var ErrCommandFailed = &CommandError{}
+
+// CommandError is returned by the WaitForCommand methods when a command
+// finishes with a status other than completed: failed, aborted, cancelled or orphaned.
+type CommandError struct {
+	ID      int64
+	Name    string
+	Status  string
+	Message string
+}
+
+// Error returns the formatted error message for a failed command.
+func (c *CommandError) Error() string {
+	if c.Message == "" {
+		return fmt.Sprintf("command %d (%s) %s", c.ID, c.Name, c.Status)
+	}
+
+	return fmt.Sprintf("command %d (%s) %s: %s", c.ID, c.Name, c.Status, c.Message)
+}
+
+// Is provides a custom error match facility. A target with an empty
+// Status matches any CommandError, otherwise the statuses must match.
+func (c *CommandError) Is(tgt error) bool {
+	target, ok := tgt.(*CommandError)
+	return ok && (target.Status == "" || target.Status == c.Status)
+}
+
+// CommandDone returns true if a command with the provided status is no longer queued or running.
+func CommandDone(status string) bool {
+	switch status {
+	case CommandCompleted, CommandFailed, CommandAborted, CommandCancelled, CommandOrphaned:
+		return true
+	default:
+		return false
+	}
+}
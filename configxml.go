@@ -0,0 +1,80 @@
+package starr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+/* This file reads the config.xml file every Starr app writes to its data folder. */
+
+// ConfigXML is the data in a Starr app's config.xml file.
+type ConfigXML struct {
+	XMLName              xml.Name `xml:"Config"`
+	BindAddress          string   `xml:"BindAddress"`
+	Port                 int      `xml:"Port"`
+	SslPort              int      `xml:"SslPort"`
+	EnableSsl            bool     `xml:"EnableSsl"`
+	APIKey               string   `xml:"ApiKey"`
+	URLBase              string   `xml:"UrlBase"`
+	InstanceName         string   `xml:"InstanceName"`
+	AuthenticationMethod string   `xml:"AuthenticationMethod"`
+	Branch               string   `xml:"Branch"`
+	LogLevel             string   `xml:"LogLevel"`
+}
+
+// ReadConfigXML reads an app's config.xml file, and returns a config to connect to the app.
+// Use this in tools that run next to the app, so the API key does not need to be copied.
+func ReadConfigXML(path string) (*Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening config.xml: %w", err)
+	}
+	defer file.Close()
+
+	return ParseConfigXML(file)
+}
+
+// ParseConfigXML parses an app's config.xml, and returns a config to connect to the app.
+// The URL includes the URL base, and uses the SSL port when SSL is enabled.
+func ParseConfigXML(input io.Reader) (*Config, error) {
+	var config ConfigXML
+	if err := xml.NewDecoder(input).Decode(&config); err != nil {
+		return nil, fmt.Errorf("xml.Decode(config.xml): %w", err)
+	}
+
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("%w: config.xml has no ApiKey", ErrInvalidConfig)
+	}
+
+	return New(config.APIKey, config.URL(), 0), nil
+}
+
+// URL returns the URL to connect to the app. Addresses that listen everywhere are replaced with localhost.
+func (c *ConfigXML) URL() string {
+	scheme, port := "http", c.Port
+	if c.EnableSsl {
+		scheme, port = "https", c.SslPort
+	}
+
+	host := strings.TrimSpace(c.BindAddress)
+	switch host {
+	case "", "*", "0.0.0.0", "::", "[::]":
+		host = "localhost"
+	}
+
+	if port != 0 {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(port))
+	}
+
+	urlBase := strings.Trim(strings.TrimSpace(c.URLBase), "/")
+	if urlBase != "" {
+		urlBase = "/" + urlBase
+	}
+
+	return scheme + "://" + host + urlBase
+}
@@ -0,0 +1,35 @@
+package starr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfigXML(t *testing.T) {
+	t.Parallel()
+
+	config, err := starr.ParseConfigXML(strings.NewReader(`<Config>
+  <BindAddress>*</BindAddress>
+  <Port>8989</Port>
+  <SslPort>9898</SslPort>
+  <EnableSsl>False</EnableSsl>
+  <ApiKey>0123456789abcdef</ApiKey>
+  <AuthenticationMethod>Forms</AuthenticationMethod>
+  <UrlBase>/sonarr/</UrlBase>
+  <InstanceName>Sonarr</InstanceName>
+</Config>`))
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", config.APIKey)
+	assert.Equal(t, "http://localhost:8989/sonarr", config.URL)
+	assert.NotNil(t, config.Client)
+
+	xml := &starr.ConfigXML{BindAddress: "::1", Port: 7878, SslPort: 9898, EnableSsl: true}
+	assert.Equal(t, "https://[::1]:9898", xml.URL(), "ssl must use the ssl port")
+
+	_, err = starr.ParseConfigXML(strings.NewReader(`<Config><Port>8989</Port></Config>`))
+	require.ErrorIs(t, err, starr.ErrInvalidConfig)
+}
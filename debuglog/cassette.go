@@ -0,0 +1,333 @@
+package debuglog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects what a CassetteRoundTripper does with requests.
+type CassetteMode int
+
+// Cassette modes.
+const (
+	// ModeReplay serves responses from the cassette file, and never calls the next RoundTripper.
+	ModeReplay CassetteMode = iota
+	// ModeRecord sends requests to the next RoundTripper and saves every exchange to the cassette file.
+	ModeRecord
+)
+
+// ErrNoInteraction is returned in replay mode when no recorded exchange matches a request.
+var ErrNoInteraction = errors.New("no recorded interaction matches request")
+
+// redacted replaces secrets in recorded exchanges.
+const redacted = "<redacted>"
+
+// secretHeaders are always redacted in recorded responses. Set-Cookie carries the login session.
+var secretHeaders = []string{"Set-Cookie", "Cookie", "Authorization", "X-Api-Key"} //nolint:gochecknoglobals
+
+// secretQueryKeys are always redacted in recorded request queries. The signalr hub sends the API key as access_token.
+var secretQueryKeys = []string{"apikey", "access_token"} //nolint:gochecknoglobals
+
+// CassetteConfig is the input data for a CassetteRoundTripper.
+type CassetteConfig struct {
+	// Path is the cassette file. It is read in replay mode, and written in record mode.
+	Path string
+	// Mode selects record or replay.
+	Mode CassetteMode
+	// Any strings in this list are replaced with <redacted> in the cassette. String must be 4+ chars.
+	// The X-Api-Key header, apikey and access_token query parameters, and the Set-Cookie, Cookie and
+	// Authorization response headers are always redacted.
+	Redact []string
+}
+
+// Cassette is the file format for recorded exchanges.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and its response.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the part of a request that is recorded, and matched in replay mode.
+type CassetteRequest struct {
+	Method string       `json:"method"`
+	Path   string       `json:"path"`
+	Query  string       `json:"query,omitempty"`
+	Body   CassetteBody `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	Status int          `json:"status"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   CassetteBody `json:"body,omitempty"`
+}
+
+// CassetteBody is a request or response body. JSON bodies are stored as JSON so the cassette is readable.
+type CassetteBody string
+
+// CassetteRoundTripper records HTTP exchanges to a cassette file, or replays them from one.
+// Use it to test against real app payloads without a running app.
+type CassetteRoundTripper struct {
+	next   http.RoundTripper
+	config *CassetteConfig
+	mu     sync.Mutex
+	tape   *Cassette
+	used   []bool
+}
+
+// NewCassetteRoundTripper returns a round tripper that records or replays requests.
+// In replay mode the cassette file is read now, and next is not used.
+func NewCassetteRoundTripper(config CassetteConfig, next http.RoundTripper) (*CassetteRoundTripper, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	rt := &CassetteRoundTripper{next: next, config: &config, tape: &Cassette{}}
+	if config.Mode == ModeRecord {
+		return rt, nil
+	}
+
+	data, err := os.ReadFile(config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	if err := json.Unmarshal(data, rt.tape); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(cassette): %w", err)
+	}
+
+	rt.used = make([]bool, len(rt.tape.Interactions))
+
+	return rt, nil
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *CassetteRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var sent []byte
+
+	if req.Body != nil {
+		var err error
+		if sent, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(sent))
+	}
+
+	redact := rt.config.Redact
+	if key := req.Header.Get("X-Api-Key"); key != "" {
+		redact = append([]string{key}, redact...)
+	}
+
+	recorded := CassetteRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  redactQuery(req.URL.Query(), redact),
+		Body:   CassetteBody(redactString(string(sent), redact)),
+	}
+
+	if rt.config.Mode == ModeRecord {
+		return rt.record(req, &recorded, redact)
+	}
+
+	return rt.replay(req, &recorded)
+}
+
+// record sends the request and saves the exchange.
+func (rt *CassetteRoundTripper) record(
+	req *http.Request,
+	recorded *CassetteRequest,
+	redact []string,
+) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return resp, err //nolint:wrapcheck
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	header := resp.Header.Clone()
+
+	for _, values := range header {
+		for idx := range values {
+			values[idx] = redactString(values[idx], redact)
+		}
+	}
+
+	for _, name := range secretHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redacted)
+		}
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.tape.Interactions = append(rt.tape.Interactions, &Interaction{
+		Request:  *recorded,
+		Response: CassetteResponse{Status: resp.StatusCode, Header: header, Body: CassetteBody(redactString(string(body), redact))},
+	})
+
+	if err := rt.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// save writes the cassette file. Called with the lock held.
+func (rt *CassetteRoundTripper) save() error {
+	data, err := json.MarshalIndent(rt.tape, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json.Marshal(cassette): %w", err)
+	}
+
+	if err := os.WriteFile(rt.config.Path, data, 0o600); err != nil { //nolint:mnd
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	return nil
+}
+
+// replay returns the first unused exchange that matches the request.
+// When every match was used, the last one is returned again, so repeated requests work.
+func (rt *CassetteRoundTripper) replay(req *http.Request, recorded *CassetteRequest) (*http.Response, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	found := -1
+
+	for idx, interaction := range rt.tape.Interactions {
+		if !interaction.Request.matches(recorded) {
+			continue
+		}
+
+		found = idx
+		if !rt.used[idx] {
+			break
+		}
+	}
+
+	if found == -1 {
+		return nil, fmt.Errorf("%w: %s %s?%s", ErrNoInteraction, recorded.Method, recorded.Path, recorded.Query)
+	}
+
+	rt.used[found] = true
+	resp := rt.tape.Interactions[found].Response
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.Status, http.StatusText(resp.Status)),
+		StatusCode:    resp.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(string(resp.Body))),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matches compares method, path, query and body. JSON bodies are compared by value.
+func (c *CassetteRequest) matches(req *CassetteRequest) bool {
+	if c.Method != req.Method || c.Path != req.Path || c.Query != req.Query {
+		return false
+	}
+
+	if c.Body == req.Body {
+		return true
+	}
+
+	var have, want interface{}
+
+	return json.Unmarshal([]byte(c.Body), &have) == nil && json.Unmarshal([]byte(req.Body), &want) == nil &&
+		jsonEqual(have, want)
+}
+
+func jsonEqual(have, want interface{}) bool {
+	haveData, _ := json.Marshal(have)
+	wantData, _ := json.Marshal(want)
+
+	return bytes.Equal(haveData, wantData)
+}
+
+// MarshalJSON writes JSON bodies as JSON, and anything else as a string.
+func (b CassetteBody) MarshalJSON() ([]byte, error) {
+	if b != "" && json.Valid([]byte(b)) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(b)); err != nil {
+			return nil, fmt.Errorf("json.Compact: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return json.Marshal(string(b)) //nolint:wrapcheck
+}
+
+// UnmarshalJSON reads a body written by MarshalJSON.
+// A body that was a JSON string is read back as the string, without quotes.
+func (b *CassetteBody) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return fmt.Errorf("json.Unmarshal(body): %w", err)
+		}
+
+		*b = CassetteBody(str)
+
+		return nil
+	}
+
+	*b = CassetteBody(data)
+
+	return nil
+}
+
+func redactQuery(query url.Values, redact []string) string {
+	for key, values := range query {
+		secret := false
+
+		for _, name := range secretQueryKeys {
+			secret = secret || strings.EqualFold(key, name)
+		}
+
+		for idx := range values {
+			if secret {
+				values[idx] = redacted
+			} else {
+				values[idx] = redactString(values[idx], redact)
+			}
+		}
+	}
+
+	return query.Encode()
+}
+
+func redactString(input string, redact []string) string {
+	for _, secret := range redact {
+		if len(secret) >= minRedactChars {
+			input = strings.ReplaceAll(input, secret, redacted)
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,111 @@
+package debuglog_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/debuglog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	const apiKey = "secret-api-key"
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"path":"` + req.URL.Path + `","sent":` + string(body) +
+			`,"apiKey":"` + req.Header.Get("X-Api-Key") + `"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := debuglog.NewCassetteRoundTripper(debuglog.CassetteConfig{Path: path, Mode: debuglog.ModeRecord}, nil)
+	require.NoError(t, err)
+
+	config := starr.New(apiKey, server.URL, 0)
+	config.Client.Transport = recorder
+
+	var output map[string]interface{}
+
+	err = config.PostInto(context.Background(),
+		starr.Request{URI: "/thing", Body: strings.NewReader(`{"b":2,"a":1}`)}, &output)
+	require.NoError(t, err)
+	assert.Equal(t, apiKey, output["apiKey"], "the caller must get the real response")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), apiKey, "the api key must be redacted")
+	assert.Contains(t, string(data), `"path": "/api/thing"`, "JSON bodies must be stored as JSON")
+
+	// Replay, without the server.
+	server.Close()
+
+	player, err := debuglog.NewCassetteRoundTripper(debuglog.CassetteConfig{Path: path}, nil)
+	require.NoError(t, err)
+
+	config.Client.Transport = player
+
+	for i := 0; i < 2; i++ {
+		output = nil
+		err = config.PostInto(context.Background(),
+			starr.Request{URI: "/thing", Body: strings.NewReader(`{"a":1, "b":2}`)}, &output)
+		require.NoError(t, err, "JSON bodies must match by value, and may be replayed again")
+		assert.Equal(t, "/api/thing", output["path"])
+		assert.Equal(t, "<redacted>", output["apiKey"])
+	}
+
+	err = config.PostInto(context.Background(),
+		starr.Request{URI: "/thing", Body: strings.NewReader(`{"a":2}`)}, &output)
+	require.ErrorIs(t, err, debuglog.ErrNoInteraction)
+}
+
+func TestCassetteSecretHeaders(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(writer, &http.Cookie{Name: "SonarrAuth", Value: "session-cookie"})
+		writer.Header().Set("Authorization", "Basic dXNlcjpwYXNz")
+		_, _ = writer.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := debuglog.NewCassetteRoundTripper(debuglog.CassetteConfig{Path: path, Mode: debuglog.ModeRecord}, nil)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: recorder}
+
+	resp, err := client.Get(server.URL + "/signalr/messages?id=1&access_token=hub-api-key") //nolint:noctx
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), "session-cookie", "the caller must get the real headers")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "session-cookie", "session cookies must be redacted")
+	assert.NotContains(t, string(data), "dXNlcjpwYXNz", "authorization headers must be redacted")
+	assert.NotContains(t, string(data), "hub-api-key", "access_token query parameters must be redacted")
+	assert.Contains(t, string(data), "id=1", "other query parameters must be kept")
+
+	// A cassette that cannot be saved returns an error, and no response.
+	recorder, err = debuglog.NewCassetteRoundTripper(debuglog.CassetteConfig{
+		Path: filepath.Join(t.TempDir(), "missing", "cassette.json"),
+		Mode: debuglog.ModeRecord,
+	}, nil)
+	require.NoError(t, err)
+
+	resp, err = (&http.Client{Transport: recorder}).Get(server.URL) //nolint:noctx
+	require.Error(t, err)
+	assert.Nil(t, resp)
+}
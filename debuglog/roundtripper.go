@@ -2,6 +2,7 @@
 // an HTTP client Transport to log requests made with that client.
 // This has been proven useful for finding starr app API payloads,
 // and as a general debug log wrapper for an integrating application.
+// The CassetteRoundTripper records real exchanges to a file and replays them in tests.
 package debuglog
 
 import (
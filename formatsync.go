@@ -0,0 +1,344 @@
+package starr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/* This file contains helpers for the custom format sync methods in Radarr and Sonarr. */
+
+// DefaultScoreSet is the score set used when ProfileScores.ScoreSet is empty.
+const DefaultScoreSet = "default"
+
+// Errors returned when syncing custom formats.
+var (
+	ErrNoFormatName    = errors.New("custom format has no name")
+	ErrUnknownFormat   = errors.New("custom format does not exist")
+	ErrProfileNotFound = errors.New("quality profile does not exist")
+)
+
+// CustomFormatFile is a custom format definition, as the apps import and export them.
+// Community guides use the same format, and may add a set of recommended scores.
+type CustomFormatFile struct {
+	TrashID               string                  `json:"trash_id,omitempty"`
+	TrashScores           map[string]int64        `json:"trash_scores,omitempty"`
+	Name                  string                  `json:"name"`
+	IncludeCFWhenRenaming bool                    `json:"includeCustomFormatWhenRenaming"`
+	Specifications        []*CustomFormatFileSpec `json:"specifications"`
+}
+
+// CustomFormatFileSpec is part of a CustomFormatFile.
+// Fields may be a list of name/value pairs, or an object of names to values, as the apps export them.
+type CustomFormatFileSpec struct {
+	Name           string        `json:"name"`
+	Implementation string        `json:"implementation"`
+	Negate         bool          `json:"negate"`
+	Required       bool          `json:"required"`
+	Fields         []*FieldInput `json:"fields"`
+}
+
+// ProfileScores are the custom format scores to set on a quality profile.
+// A format's score comes from Scores if it is there, otherwise from the format file's score set.
+// Formats with neither keep the score they have.
+type ProfileScores struct {
+	// Profile is the name of the quality profile to update.
+	Profile string
+	// ScoreSet is the key in each format file's trash_scores to use. Defaults to DefaultScoreSet.
+	ScoreSet string
+	// Scores are custom format names and the score to set for them.
+	// These may name any custom format in the app, not just the synced ones.
+	Scores map[string]int64
+	// MinFormatScore and CutoffFormatScore are set on the profile when not nil.
+	MinFormatScore    *int64
+	CutoffFormatScore *int64
+}
+
+// FormatSync is the output from a custom format sync, and lists what changed.
+type FormatSync struct {
+	Created   []string              // Names of the custom formats that were created.
+	Updated   []string              // Names of the custom formats that were updated.
+	Unchanged []string              // Names of the custom formats that already matched.
+	Profiles  []*ProfileScoreChange // Quality profiles that were updated.
+}
+
+// ProfileScoreChange lists the changes made to one quality profile.
+type ProfileScoreChange struct {
+	Profile           string
+	Scores            []*ScoreChange
+	MinFormatScore    *ScoreChange
+	CutoffFormatScore *ScoreChange
+}
+
+// ScoreChange is a score that changed. Name is the custom format name.
+type ScoreChange struct {
+	Name string
+	Old  int64
+	New  int64
+}
+
+// UnmarshalJSON accepts fields as an object or as a list.
+func (s *CustomFormatFileSpec) UnmarshalJSON(data []byte) error {
+	type spec CustomFormatFileSpec
+
+	var input struct {
+		spec
+		Fields json.RawMessage `json:"fields"`
+	}
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("json.Unmarshal(spec): %w", err)
+	}
+
+	*s = CustomFormatFileSpec(input.spec)
+
+	if fields := bytes.TrimSpace(input.Fields); len(fields) > 0 && fields[0] == '{' {
+		var values map[string]interface{}
+		if err := json.Unmarshal(fields, &values); err != nil {
+			return fmt.Errorf("json.Unmarshal(fields): %w", err)
+		}
+
+		for name, value := range values {
+			s.Fields = append(s.Fields, &FieldInput{Name: name, Value: value})
+		}
+
+		sort.Slice(s.Fields, func(i, j int) bool { return s.Fields[i].Name < s.Fields[j].Name })
+	} else if len(fields) > 0 && string(fields) != "null" {
+		if err := json.Unmarshal(fields, &s.Fields); err != nil {
+			return fmt.Errorf("json.Unmarshal(fields): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReadCustomFormats reads every .json file in fsys as a custom format definition.
+// A file may hold one definition or a list of them. Use os.DirFS to read a directory.
+func ReadCustomFormats(fsys fs.FS) ([]*CustomFormatFile, error) {
+	var formats []*CustomFormatFile
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.EqualFold(path.Ext(name), ".json") {
+			return err
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("reading custom format: %w", err)
+		}
+
+		read, err := decodeCustomFormats(data)
+		if err != nil {
+			return fmt.Errorf("reading custom format %s: %w", name, err)
+		}
+
+		formats = append(formats, read...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return formats, nil
+}
+
+func decodeCustomFormats(data []byte) ([]*CustomFormatFile, error) {
+	var formats []*CustomFormatFile
+
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &formats); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+	} else {
+		var format CustomFormatFile
+		if err := json.Unmarshal(data, &format); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		formats = append(formats, &format)
+	}
+
+	for _, format := range formats {
+		if format.Name == "" {
+			return nil, ErrNoFormatName
+		}
+	}
+
+	return formats, nil
+}
+
+// Matches returns true if existing already has this format's settings.
+// Only the fields in this format are compared; existing may have more.
+func (f *CustomFormatFile) Matches(existing *CustomFormatFile) bool {
+	if !strings.EqualFold(f.Name, existing.Name) || f.IncludeCFWhenRenaming != existing.IncludeCFWhenRenaming ||
+		len(f.Specifications) != len(existing.Specifications) {
+		return false
+	}
+
+	for idx, spec := range f.Specifications {
+		have := existing.Specifications[idx]
+		if spec.Name != have.Name || spec.Implementation != have.Implementation ||
+			spec.Negate != have.Negate || spec.Required != have.Required {
+			return false
+		}
+
+		for _, field := range spec.Fields {
+			if !hasField(have.Fields, field) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func hasField(fields []*FieldInput, want *FieldInput) bool {
+	for _, field := range fields {
+		if field.Name != want.Name {
+			continue
+		}
+
+		// The apps omit empty values.
+		if field.Value == nil || want.Value == nil {
+			return isZero(field.Value) && isZero(want.Value)
+		}
+
+		return reflect.DeepEqual(field.Value, want.Value)
+	}
+
+	return false
+}
+
+func isZero(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// Score returns the score for a custom format from Scores or from the format's score set.
+func (p *ProfileScores) Score(format *CustomFormatFile) (int64, bool) {
+	for name, score := range p.Scores {
+		if strings.EqualFold(name, format.Name) {
+			return score, true
+		}
+	}
+
+	set := p.ScoreSet
+	if set == "" {
+		set = DefaultScoreSet
+	}
+
+	score, ok := format.TrashScores[set]
+
+	return score, ok
+}
+
+// SetScores updates a quality profile's format items and format scores, and returns what changed.
+// ids maps lowercase custom format names to their IDs in the app. Format items are added if missing.
+// The returned change is nil if nothing changed.
+func (p *ProfileScores) SetScores(
+	formats []*CustomFormatFile,
+	ids map[string]int64,
+	items *[]*FormatItem,
+	minScore, cutoffScore *int64,
+) (*ProfileScoreChange, error) {
+	scores := make(map[string]int64, len(formats)+len(p.Scores))
+	names := make(map[string]string, len(formats)+len(p.Scores))
+
+	for name, score := range p.Scores {
+		scores[strings.ToLower(name)], names[strings.ToLower(name)] = score, name
+	}
+
+	// Prefer the format's own name, and Score gives Scores priority over the score set.
+	for _, format := range formats {
+		if score, ok := p.Score(format); ok {
+			scores[strings.ToLower(format.Name)], names[strings.ToLower(format.Name)] = score, format.Name
+		}
+	}
+
+	keys := make([]string, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	change := &ProfileScoreChange{Profile: p.Profile}
+
+	for _, key := range keys {
+		id, ok := ids[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s'", ErrUnknownFormat, names[key])
+		}
+
+		if scoreChange := setScore(items, id, names[key], scores[key]); scoreChange != nil {
+			change.Scores = append(change.Scores, scoreChange)
+		}
+	}
+
+	if p.MinFormatScore != nil && *p.MinFormatScore != *minScore {
+		change.MinFormatScore = &ScoreChange{Name: "minFormatScore", Old: *minScore, New: *p.MinFormatScore}
+		*minScore = *p.MinFormatScore
+	}
+
+	if p.CutoffFormatScore != nil && *p.CutoffFormatScore != *cutoffScore {
+		change.CutoffFormatScore = &ScoreChange{Name: "cutoffFormatScore", Old: *cutoffScore, New: *p.CutoffFormatScore}
+		*cutoffScore = *p.CutoffFormatScore
+	}
+
+	if len(change.Scores) == 0 && change.MinFormatScore == nil && change.CutoffFormatScore == nil {
+		return nil, nil //nolint:nilnil // nil means nothing changed.
+	}
+
+	return change, nil
+}
+
+func setScore(items *[]*FormatItem, id int64, name string, score int64) *ScoreChange {
+	for _, item := range *items {
+		if item.Format != id {
+			continue
+		}
+
+		if item.Score == score {
+			return nil
+		}
+
+		change := &ScoreChange{Name: name, Old: item.Score, New: score}
+		item.Score = score
+
+		return change
+	}
+
+	*items = append(*items, &FormatItem{Format: id, Name: name, Score: score})
+
+	return &ScoreChange{Name: name, New: score}
+}
+
+// String returns the changes, one per line.
+func (s *FormatSync) String() string {
+	var buf strings.Builder
+
+	for _, name := range s.Created {
+		fmt.Fprintf(&buf, "created custom format '%s'\n", name)
+	}
+
+	for _, name := range s.Updated {
+		fmt.Fprintf(&buf, "updated custom format '%s'\n", name)
+	}
+
+	for _, profile := range s.Profiles {
+		changes := append([]*ScoreChange{}, profile.Scores...)
+		for _, change := range append(changes, profile.MinFormatScore, profile.CutoffFormatScore) {
+			if change != nil {
+				fmt.Fprintf(&buf, "profile '%s': %s %d -> %d\n", profile.Profile, change.Name, change.Old, change.New)
+			}
+		}
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,84 @@
+package starr_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCustomFormats(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"x265.json": {Data: []byte(`{"trash_id":"abc","trash_scores":{"default":-10000,"anime":-500},
+			"name":"x265","includeCustomFormatWhenRenaming":false,"specifications":[
+			{"name":"x265","implementation":"ReleaseTitleSpecification","negate":false,"required":true,
+			"fields":{"value":"[xh][ .]?265"}}]}`)},
+		"guide/list.JSON": {Data: []byte(`[{"name":"HDR","specifications":[{"name":"HDR",
+			"implementation":"ReleaseTitleSpecification","fields":[{"name":"value","value":"HDR"}]}]}]`)},
+		"README.md": {Data: []byte("not a format")},
+	}
+
+	formats, err := starr.ReadCustomFormats(fsys)
+	require.NoError(t, err)
+	require.Len(t, formats, 2, "every json file must be read, in sub directories too")
+	assert.Equal(t, "HDR", formats[0].Name)
+	assert.Equal(t, []*starr.FieldInput{{Name: "value", Value: "[xh][ .]?265"}}, formats[1].Specifications[0].Fields,
+		"fields must be read from an object")
+	assert.Equal(t, []*starr.FieldInput{{Name: "value", Value: "HDR"}}, formats[0].Specifications[0].Fields)
+
+	existing := *formats[1]
+	assert.True(t, formats[1].Matches(&existing))
+
+	existing.IncludeCFWhenRenaming = true
+	assert.False(t, formats[1].Matches(&existing))
+
+	_, err = starr.ReadCustomFormats(fstest.MapFS{"bad.json": {Data: []byte(`{}`)}})
+	require.ErrorIs(t, err, starr.ErrNoFormatName)
+}
+
+func TestProfileScoresSetScores(t *testing.T) {
+	t.Parallel()
+
+	formats := []*starr.CustomFormatFile{
+		{Name: "x265", TrashScores: map[string]int64{"default": -10000, "anime": -500}},
+		{Name: "HDR"},
+	}
+	ids := map[string]int64{"x265": 1, "hdr": 2, "dv": 3}
+	items := []*starr.FormatItem{{Format: 1, Name: "x265", Score: 0}, {Format: 3, Name: "DV", Score: 5}}
+	minScore, cutoffScore, newMin := int64(0), int64(100), int64(10)
+
+	scores := &starr.ProfileScores{
+		Profile:        "HD",
+		ScoreSet:       "anime",
+		Scores:         map[string]int64{"hdr": 50, "DV": 5},
+		MinFormatScore: &newMin,
+	}
+
+	change, err := scores.SetScores(formats, ids, &items, &minScore, &cutoffScore)
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, []*starr.ScoreChange{
+		{Name: "HDR", Old: 0, New: 50},
+		{Name: "x265", Old: 0, New: -500},
+	}, change.Scores, "unchanged scores must not be listed")
+	assert.Equal(t, &starr.ScoreChange{Name: "minFormatScore", Old: 0, New: 10}, change.MinFormatScore)
+	assert.Nil(t, change.CutoffFormatScore)
+	assert.Equal(t, int64(10), minScore)
+	assert.Equal(t, []*starr.FormatItem{
+		{Format: 1, Name: "x265", Score: -500},
+		{Format: 3, Name: "DV", Score: 5},
+		{Format: 2, Name: "HDR", Score: 50},
+	}, items, "missing format items must be added")
+
+	change, err = scores.SetScores(formats, ids, &items, &minScore, &cutoffScore)
+	require.NoError(t, err)
+	assert.Nil(t, change, "running it again must change nothing")
+
+	scores.Scores["missing"] = 1
+	_, err = scores.SetScores(formats, ids, &items, &minScore, &cutoffScore)
+	require.ErrorIs(t, err, starr.ErrUnknownFormat)
+}
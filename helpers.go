@@ -11,8 +11,8 @@ import (
 	"github.com/BSFishy/starr/debuglog"
 )
 
-// App can be used to satisfy a context value key.
-// It is not used in this library; provided for convenience.
+// App names a Starr app. It identifies apps in snapshots, webhooks and the manager package,
+// and can be used to satisfy a context value key.
 type App string
 
 // These constants are just here for convenience.
@@ -1,9 +1,11 @@
 package starr
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,13 +18,17 @@ import (
 // API is the beginning of every API path.
 const API = "api"
 
+// loginPath is the path to the login form in every app.
+const loginPath = "/login"
+
 /* The methods in this file provide assumption-ridden HTTP calls for Starr apps. */
 
 // Request contains the GET and/or POST values for an HTTP request.
 type Request struct {
-	URI   string     // Required: path portion of the URL.
-	Query url.Values // GET parameters work for any request type.
-	Body  io.Reader  // Used in PUT, POST, DELETE. Not for GET.
+	URI    string      // Required: path portion of the URL.
+	Query  url.Values  // GET parameters work for any request type.
+	Body   io.Reader   // Used in PUT, POST, DELETE. Not for GET.
+	Header http.Header // Optional. These replace the default headers, like Content-Type.
 }
 
 // ReqError is returned when a Starr app returns an invalid status code.
@@ -33,6 +39,22 @@ type ReqError struct {
 	Name string
 	Err  error // sub error, often nil, or not useful.
 	http.Header
+	// Validation has every failure from a 400 response with a list of validation errors.
+	// Name and Msg are copied from the first one.
+	Validation []*ValidationError
+}
+
+// ValidationError is a single validation failure returned by a Starr app.
+// Warnings can be ignored by saving again with force, on the methods that have it.
+type ValidationError struct {
+	Property       string      `json:"propertyName"`
+	Message        string      `json:"errorMessage"`
+	AttemptedValue interface{} `json:"attemptedValue"`
+	Severity       string      `json:"severity"`
+	ErrorCode      string      `json:"errorCode"`
+	InfoLink       string      `json:"infoLink"`
+	Description    string      `json:"detailedDescription"`
+	IsWarning      bool        `json:"isWarning"`
 }
 
 // String turns a request into a string. Usually used in error messages.
@@ -42,7 +64,39 @@ func (r *Request) String() string {
 
 // Req makes an authenticated request to a starr application and returns the response.
 // Do not forget to read and close the response Body if there is no error.
+// If the session has expired and Username is set, Req logs in again and retries the request once.
 func (c *Config) Req(ctx context.Context, method string, req Request) (*http.Response, error) {
+	if c.Username == "" || req.URI == loginPath {
+		return c.req(ctx, method, req)
+	}
+
+	var body []byte
+
+	if req.Body != nil {
+		// Buffer the body so it can be sent again after logging in.
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body (%s): %w", req.URI, err)
+		}
+
+		req.Body = bytes.NewReader(body)
+	}
+
+	seen := c.loginCount()
+
+	resp, err := c.req(ctx, method, req)
+	if !c.needsLogin(&req, err) {
+		return resp, err
+	}
+
+	if err := c.relogin(ctx, seen); err != nil {
+		return nil, err
+	}
+
+	if req.Body != nil {
+		req.Body = bytes.NewReader(body)
+	}
+
 	return c.req(ctx, method, req)
 }
 
@@ -53,11 +107,45 @@ func (c *Config) api(ctx context.Context, method string, req Request) (*http.Res
 }
 
 // req is our abstraction method for calling a starr application.
+// Requests are retried according to the Config's RetryPolicy, if one is set.
 func (c *Config) req(ctx context.Context, method string, req Request) (*http.Response, error) {
 	if c.Client == nil { // we must have an http client.
 		return nil, ErrNilClient
 	}
 
+	attempts := c.Retry.attempts(method)
+	if attempts < 2 { //nolint:mnd
+		return c.do(ctx, method, req)
+	}
+
+	var body []byte
+
+	if req.Body != nil {
+		// Buffer the body so it can be sent more than once.
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body (%s): %w", req.URI, err)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if req.Body != nil {
+			req.Body = bytes.NewReader(body)
+		}
+
+		resp, err := c.do(ctx, method, req)
+		if attempt >= attempts || !c.Retry.retryable(ctx, err) {
+			return resp, err
+		}
+
+		if err := sleep(ctx, c.Retry.wait(attempt, err)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do makes a single request to a starr application.
+func (c *Config) do(ctx context.Context, method string, req Request) (*http.Response, error) {
 	httpReq, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.URL, "/")+req.URI, req.Body)
 	if err != nil {
 		return nil, fmt.Errorf("http.NewRequestWithContext(%s): %w", req.URI, err)
@@ -65,19 +153,32 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 
 	c.SetHeaders(httpReq)
 
+	for key, values := range req.Header {
+		httpReq.Header[http.CanonicalHeaderKey(key)] = values
+	}
+
 	if req.Query != nil {
 		httpReq.URL.RawQuery = req.Query.Encode()
 	}
 
+	release, err := c.Limiter.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.Client.Do(httpReq)
 	if err != nil {
+		release()
 		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
 	}
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer release()
 		return nil, parseNon200(resp)
 	}
 
+	resp.Body = &releaseCloser{ReadCloser: resp.Body, release: release}
+
 	return resp, nil
 }
 
@@ -99,23 +200,22 @@ func parseNon200(resp *http.Response) *ReqError {
 		return response
 	}
 
-	type propError struct {
-		Msg  string `json:"errorMessage"`
-		Name string `json:"propertyName"`
-	}
+	var errMsg ValidationError
 
-	var errMsg propError
+	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.Message != "" {
+		response.Name, response.Msg = errMsg.Property, errMsg.Message
+		response.Validation = []*ValidationError{&errMsg}
 
-	if response.Err = json.Unmarshal(response.Body, &errMsg); response.Err == nil && errMsg.Msg != "" {
-		response.Name, response.Msg = errMsg.Name, errMsg.Msg
 		return response
 	}
 
-	// Sometimes we get a list of errors. This grabs the first one.
-	var errMsg2 []propError
+	// Validation failures come as a list of errors.
+	var errMsg2 []*ValidationError
 
 	if response.Err = json.Unmarshal(response.Body, &errMsg2); response.Err == nil && len(errMsg2) > 0 {
-		response.Name, response.Msg = errMsg2[0].Name, errMsg2[0].Msg
+		response.Name, response.Msg = errMsg2[0].Property, errMsg2[0].Message
+		response.Validation = errMsg2
+
 		return response
 	}
 
@@ -141,7 +241,7 @@ func (c *Config) SetHeaders(req *http.Request) {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.RequestURI(), "/login") {
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.RequestURI(), loginPath) {
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	} else {
 		req.Header.Set("Accept", "application/json")
@@ -174,6 +274,13 @@ func (r *ReqError) Error() string {
 	}
 
 	switch body := string(r.Body); {
+	case len(r.Validation) > 1:
+		list := make([]string, len(r.Validation))
+		for idx, failure := range r.Validation {
+			list[idx] = failure.Error()
+		}
+
+		return fmt.Sprintf("%s, %s", msg, strings.Join(list, "; "))
 	case r.Name != "":
 		return fmt.Sprintf("%s, %s: %s", msg, r.Name, r.Msg)
 	case r.Msg != "":
@@ -192,3 +299,36 @@ func (r *ReqError) Is(tgt error) bool {
 	target, ok := tgt.(*ReqError)
 	return ok && (r.Code == target.Code || target.Code == -1)
 }
+
+// Error returns the property name and message, so a validation failure can be used as an error.
+func (v *ValidationError) Error() string {
+	if v.Property == "" {
+		return v.Message
+	}
+
+	return v.Property + ": " + v.Message
+}
+
+// ValidationErrors returns every validation failure in err, or nil if err has none.
+func ValidationErrors(err error) []*ValidationError {
+	var reqErr *ReqError
+	if errors.As(err, &reqErr) {
+		return reqErr.Validation
+	}
+
+	return nil
+}
+
+// IsWarning returns true if err has validation failures and they are all warnings.
+// Saving again with force ignores warnings, like UpdateIndexer(indexer, true).
+func IsWarning(err error) bool {
+	failures := ValidationErrors(err)
+
+	for _, failure := range failures {
+		if !failure.IsWarning {
+			return false
+		}
+	}
+
+	return len(failures) > 0
+}
@@ -1,7 +1,10 @@
 package starr_test
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 
@@ -52,3 +55,43 @@ func TestReqError(t *testing.T) {
 	err.Name = "Varname"
 	assert.Equal(t, "invalid status code, 403 >= 300, Varname: Some message", err.Error())
 }
+
+func TestValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		writer.WriteHeader(http.StatusBadRequest)
+
+		if req.URL.Path == "/api/warning" {
+			_, _ = writer.Write([]byte(`[{"propertyName":"","errorMessage":"Unable to connect","isWarning":true}]`))
+			return
+		}
+
+		_, _ = writer.Write([]byte(`[
+			{"propertyName":"BaseUrl","errorMessage":"Invalid URL","attemptedValue":"x:/","severity":"error",
+			 "infoLink":"https://wiki","isWarning":false},
+			{"propertyName":"ApiKey","errorMessage":"Must not be empty","attemptedValue":"","severity":"error"}]`))
+	}))
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+
+	var output interface{}
+
+	err := config.GetInto(context.Background(), starr.Request{URI: "/invalid"}, &output)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.Equal(t, "invalid status code, 400 >= 300, BaseUrl: Invalid URL; ApiKey: Must not be empty", err.Error())
+	assert.False(t, starr.IsWarning(err))
+
+	failures := starr.ValidationErrors(fmt.Errorf("wrapped: %w", err))
+	require.Len(t, failures, 2, "every failure must be kept")
+	assert.Equal(t, &starr.ValidationError{
+		Property: "BaseUrl", Message: "Invalid URL", AttemptedValue: "x:/", Severity: "error", InfoLink: "https://wiki",
+	}, failures[0])
+
+	err = config.GetInto(context.Background(), starr.Request{URI: "/warning"}, &output)
+	assert.True(t, starr.IsWarning(err), "a save with only warnings can be forced")
+	assert.Equal(t, "invalid status code, 400 >= 300, Unable to connect", err.Error())
+	assert.False(t, starr.IsWarning(nil))
+	assert.Nil(t, starr.ValidationErrors(&starr.ReqError{Code: http.StatusNotFound}))
+}
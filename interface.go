@@ -55,7 +55,16 @@ type InitializeJS struct {
 }
 
 // Login POSTs to the login form in a Starr app and saves the authentication cookie for future use.
+// Non-API requests call this automatically when the session expires, if Username is set.
 func (c *Config) Login(ctx context.Context) error {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	return c.login(ctx)
+}
+
+// login does the work for Login. The session lock must be held.
+func (c *Config) login(ctx context.Context) error {
 	if c.Client.Jar == nil {
 		jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
 		if err != nil {
@@ -69,7 +78,7 @@ func (c *Config) Login(ctx context.Context) error {
 	params.Add("username", c.Username)
 	params.Add("password", c.Password)
 
-	req := Request{URI: "/login", Body: bytes.NewBufferString(params.Encode())}
+	req := Request{URI: loginPath, Body: bytes.NewBufferString(params.Encode())}
 	codeErr := &ReqError{}
 
 	resp, err := c.req(ctx, http.MethodPost, req)
@@ -89,11 +98,50 @@ func (c *Config) Login(ctx context.Context) error {
 		return fmt.Errorf("%w: authenticating as user '%s' failed", ErrRequestError, c.Username)
 	}
 
-	c.cookie = true
+	c.logins++
 
 	return nil
 }
 
+// loginCount returns the number of successful logins.
+func (c *Config) loginCount() uint64 {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	return c.logins
+}
+
+// relogin logs in again, unless another request already did since seen was read.
+func (c *Config) relogin(ctx context.Context, seen uint64) error {
+	c.session.Lock()
+	defer c.session.Unlock()
+
+	if c.logins != seen {
+		return nil
+	}
+
+	return c.login(ctx)
+}
+
+// needsLogin returns true if a non-API request failed because the session is missing or expired.
+// Apps reply with a 401, or with a redirect to the login page.
+func (c *Config) needsLogin(req *Request, err error) bool {
+	var reqErr *ReqError
+
+	if c.Username == "" || req.URI == loginPath || !errors.As(err, &reqErr) {
+		return false
+	}
+
+	switch reqErr.Code {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect:
+		return strings.Contains(strings.ToLower(reqErr.Get("Location")), loginPath)
+	default:
+		return false
+	}
+}
+
 // Get makes a GET http request and returns the body.
 func (c *Config) Get(ctx context.Context, req Request) (*http.Response, error) {
 	return c.Req(ctx, http.MethodGet, req)
@@ -162,24 +210,16 @@ func decode(output interface{}, resp *http.Response, err error) error {
 }
 
 // GetInitializeJS returns the data from the initialize.js file.
-// If the instance requires authentication, you must call Login() before this method.
+// If the instance requires authentication, set Username and Password, or call Login() before this method.
 func (c *Config) GetInitializeJS(ctx context.Context) (*InitializeJS, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL+"initialize.js", nil)
-	if err != nil {
-		return nil, fmt.Errorf("http.NewRequestWithContext(initialize.js): %w", err)
-	}
+	req := Request{URI: "/initialize.js"}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.Req(ctx, http.MethodGet, req)
 	if err != nil {
-		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		_, _ = io.Copy(io.Discard, resp.Body)
-		return nil, &ReqError{Code: resp.StatusCode}
-	}
-
 	return readInitializeJS(resp.Body)
 }
 
@@ -0,0 +1,82 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loginServer serves initialize.js to clients with the current session cookie.
+func loginServer(t *testing.T, session, logins *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		switch cookie, _ := req.Cookie("session"); {
+		case req.URL.Path == "/login":
+			_ = req.ParseForm()
+			if req.PostForm.Get("password") != "pass" {
+				http.Redirect(writer, req, "/login?loginFailed=true", http.StatusFound)
+				return
+			}
+
+			logins.Add(1)
+			http.SetCookie(writer, &http.Cookie{Name: "session", Value: strconv.Itoa(int(session.Load())), Path: "/"})
+			http.Redirect(writer, req, "/", http.StatusFound)
+		case cookie == nil:
+			http.Redirect(writer, req, "/login?returnUrl=/initialize.js", http.StatusFound)
+		case cookie.Value != strconv.Itoa(int(session.Load())):
+			writer.WriteHeader(http.StatusUnauthorized)
+		default:
+			_, _ = writer.Write([]byte("window.Sonarr = {\n  apiKey: 'key',\n  version: '4.0.0'\n};\n"))
+		}
+	}))
+}
+
+func TestRelogin(t *testing.T) {
+	t.Parallel()
+
+	var session, logins atomic.Int32
+
+	server := loginServer(t, &session, &logins)
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+	config.Username, config.Password = "user", "pass"
+
+	js, err := config.GetInitializeJS(context.Background())
+	require.NoError(t, err, "a redirect to the login page must log in")
+	assert.Equal(t, "4.0.0", js.Version)
+	assert.EqualValues(t, 1, logins.Load())
+
+	session.Add(1) // expire the session.
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := config.GetInitializeJS(context.Background())
+			assert.NoError(t, err, "a 401 must log in again")
+		}()
+	}
+
+	wg.Wait()
+	assert.EqualValues(t, 2, logins.Load(), "concurrent requests must only log in again once")
+
+	config.Password = "wrong"
+	session.Add(1)
+
+	_, err = config.GetInitializeJS(context.Background())
+	require.ErrorIs(t, err, starr.ErrRequestError, "a failed login must be returned")
+}
@@ -0,0 +1,180 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+// CreateBackup triggers a manual Lidarr backup, waits for it to finish, and returns the new backup file.
+func (l *Lidarr) CreateBackup() (*starr.BackupFile, error) {
+	return l.CreateBackupContext(context.Background())
+}
+
+// CreateBackupContext triggers a manual Lidarr backup, waits for it to finish, and returns the new backup file.
+// Use a context with a deadline to limit how long this waits.
+func (l *Lidarr) CreateBackupContext(ctx context.Context) (*starr.BackupFile, error) {
+	cmd, err := l.SendCommandContext(ctx, BackupCommand())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := l.WaitForCommandContext(ctx, cmd.ID, 0); err != nil {
+		return nil, err
+	}
+
+	backups, err := l.GetBackupFilesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var newest *starr.BackupFile
+
+	for _, backup := range backups {
+		if backup.Type == "manual" && (newest == nil || backup.Time.After(newest.Time)) {
+			newest = backup
+		}
+	}
+
+	if newest == nil {
+		return nil, fmt.Errorf("%w: backup command finished, but no manual backup file was found", starr.ErrRequestError)
+	}
+
+	return newest, nil
+}
+
+// DownloadBackup streams a backup file into the provided writer. Get the backup path from
+// GetBackupFiles or CreateBackup. Returns the number of bytes written.
+// If the session expired and Username is set, the config logs in again and the download is tried again.
+func (l *Lidarr) DownloadBackup(backupPath string, output io.Writer) (int64, error) {
+	return l.DownloadBackupContext(context.Background(), backupPath, output)
+}
+
+// DownloadBackupContext streams a backup file into the provided writer. Get the backup path from
+// GetBackupFiles or CreateBackup. Returns the number of bytes written.
+// If the session expired and Username is set, the config logs in again and the download is tried again.
+func (l *Lidarr) DownloadBackupContext(ctx context.Context, backupPath string, output io.Writer) (int64, error) {
+	req := starr.Request{URI: path.Join("/", backupPath)}
+
+	resp, err := l.Get(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	size, err := io.Copy(output, resp.Body)
+	if err != nil {
+		return size, fmt.Errorf("writing backup file (%s): %w", &req, err)
+	}
+
+	return size, nil
+}
+
+// DeleteBackup removes a backup file from Lidarr.
+func (l *Lidarr) DeleteBackup(backupID int64) error {
+	return l.DeleteBackupContext(context.Background(), backupID)
+}
+
+// DeleteBackupContext removes a backup file from Lidarr.
+func (l *Lidarr) DeleteBackupContext(ctx context.Context, backupID int64) error {
+	req := starr.Request{URI: path.Join(bpSystem, "backup", starr.Str(backupID))}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// RestoreBackup restores an existing backup file. Lidarr restarts after a restore.
+func (l *Lidarr) RestoreBackup(backupID int64) error {
+	return l.RestoreBackupContext(context.Background(), backupID)
+}
+
+// RestoreBackupContext restores an existing backup file. Lidarr restarts after a restore.
+func (l *Lidarr) RestoreBackupContext(ctx context.Context, backupID int64) error {
+	var output interface{} // any ok
+
+	req := starr.Request{URI: path.Join(bpSystem, "backup", "restore", starr.Str(backupID))}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UploadRestore uploads a backup file and restores it. Lidarr restarts after a restore.
+// The file name must end with .zip, .db or .xml.
+func (l *Lidarr) UploadRestore(fileName string, backup io.Reader) error {
+	return l.UploadRestoreContext(context.Background(), fileName, backup)
+}
+
+// UploadRestoreContext uploads a backup file and restores it. Lidarr restarts after a restore.
+// The file name must end with .zip, .db or .xml. The backup is streamed, not buffered.
+func (l *Lidarr) UploadRestoreContext(ctx context.Context, fileName string, backup io.Reader) error {
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+
+	go func() {
+		part, err := form.CreateFormFile(starr.BackupRestoreField, path.Base(fileName))
+		if err == nil {
+			_, err = io.Copy(part, backup)
+		}
+
+		if err == nil {
+			err = form.Close()
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	var output interface{} // any ok
+
+	req := starr.Request{
+		URI:    path.Join(bpSystem, "backup", "restore", "upload"),
+		Body:   reader,
+		Header: http.Header{"Content-Type": []string{form.FormDataContentType()}},
+	}
+
+	err := l.PostInto(ctx, req, &output)
+	reader.CloseWithError(err) // Stop the writer if the request failed early.
+
+	if err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// PruneBackups deletes backup files to satisfy a retention policy, and returns the deleted files.
+// The newest keep backups are retained, and any backup older than maxAge is deleted.
+// Use 0 to disable either limit. See starr.BackupsToPrune for details.
+func (l *Lidarr) PruneBackups(keep int, maxAge time.Duration) ([]*starr.BackupFile, error) {
+	return l.PruneBackupsContext(context.Background(), keep, maxAge)
+}
+
+// PruneBackupsContext deletes backup files to satisfy a retention policy, and returns the deleted files.
+// On error, the returned list contains the files that were deleted before the error.
+func (l *Lidarr) PruneBackupsContext(ctx context.Context, keep int, maxAge time.Duration) ([]*starr.BackupFile, error) {
+	backups, err := l.GetBackupFilesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []*starr.BackupFile{}
+
+	for _, backup := range starr.BackupsToPrune(backups, keep, maxAge) {
+		if err := l.DeleteBackupContext(ctx, backup.ID); err != nil {
+			return deleted, err
+		}
+
+		deleted = append(deleted, backup)
+	}
+
+	return deleted, nil
+}
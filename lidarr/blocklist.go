@@ -93,6 +93,19 @@ func (l *Lidarr) GetBlockListPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// BlockListPager returns a pager to walk the Lidarr block list one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (l *Lidarr) BlockListPager(params *starr.PageReq) *starr.Pager[*BlockListRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*BlockListRecord, int, error) {
+		page, err := l.GetBlockListPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteBlockList removes a single block list item.
 func (l *Lidarr) DeleteBlockList(listID int64) error {
 	return l.DeleteBlockListContext(context.Background(), listID)
@@ -14,13 +14,16 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v1/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Use the *Command() constructors, like AlbumSearchCommand(), to build a valid request.
 type CommandRequest struct {
-	Name     string   `json:"name"`
-	AlbumIDs []int64  `json:"albumIds,omitempty"`
-	AlbumID  int64    `json:"albumId,omitempty"`
-	Folders  []string `json:"folders,omitempty"`
-	ArtistID int64    `json:"artistId,omitempty"`
+	Name      string   `json:"name"`
+	AlbumIDs  []int64  `json:"albumIds,omitempty"`
+	AlbumID   int64    `json:"albumId,omitempty"`
+	Folders   []string `json:"folders,omitempty"`
+	ArtistID  int64    `json:"artistId,omitempty"`
+	ArtistIDs []int64  `json:"artistIds,omitempty"`
+	Files     []int64  `json:"files,omitempty"` // RenameFiles only
+	Path      string   `json:"path,omitempty"`  // DownloadedAlbumsScan only
 }
 
 // CommandResponse comes from the /api/v1/command endpoint.
@@ -73,6 +76,10 @@ func (l *Lidarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 		return &output, nil
 	}
 
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(cmd); err != nil {
 		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCommand, err)
@@ -106,3 +113,54 @@ func (l *Lidarr) GetCommandStatusContext(ctx context.Context, commandID int64) (
 
 	return &output, nil
 }
+
+// WaitForCommand polls a command's status until it is completed, failed or aborted.
+// The final command status is returned. A command that does not complete
+// returns a *starr.CommandError, which matches starr.ErrCommandFailed.
+// pollInterval defaults to starr.DefaultCommandPollInterval when zero.
+func (l *Lidarr) WaitForCommand(commandID int64, pollInterval time.Duration) (*CommandResponse, error) {
+	return l.WaitForCommandContext(context.Background(), commandID, pollInterval)
+}
+
+// WaitForCommandContext polls a command's status until it is completed, failed or aborted.
+// Use a context with a deadline to limit how long this waits. See WaitForCommand for more.
+func (l *Lidarr) WaitForCommandContext(
+	ctx context.Context,
+	commandID int64,
+	pollInterval time.Duration,
+) (*CommandResponse, error) {
+	if commandID < 1 {
+		return nil, fmt.Errorf("%w: invalid command ID: %d", starr.ErrRequestError, commandID)
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = starr.DefaultCommandPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := l.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Status == starr.CommandCompleted {
+			return status, nil
+		} else if starr.CommandDone(status.Status) {
+			return status, &starr.CommandError{
+				ID:      status.ID,
+				Name:    status.Name,
+				Status:  status.Status,
+				Message: status.Message,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for command %d: %w", commandID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package lidarr
+
+import (
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+/* This file contains constructors for the commands Lidarr supports. Use them with SendCommand. */
+
+// Validate returns an error if the command is missing a field its name requires.
+// SendCommand calls this before sending the request.
+func (c *CommandRequest) Validate() error {
+	var missing string
+
+	switch c.Name {
+	case "AlbumSearch":
+		if len(c.AlbumIDs) == 0 {
+			missing = "albumIds"
+		}
+	case "ArtistSearch":
+		if c.ArtistID < 1 {
+			missing = "artistId"
+		}
+	case "RefreshAlbum":
+		if c.AlbumID < 1 {
+			missing = "albumId"
+		}
+	case "RenameFiles":
+		if c.ArtistID < 1 {
+			missing = "artistId"
+		} else if len(c.Files) == 0 {
+			missing = "files"
+		}
+	case "RenameArtist":
+		if len(c.ArtistIDs) == 0 {
+			missing = "artistIds"
+		}
+	case "DownloadedAlbumsScan":
+		if c.Path == "" {
+			missing = "path"
+		}
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%w: %s command requires %s", starr.ErrRequestError, c.Name, missing)
+	}
+
+	return nil
+}
+
+// AlbumSearchCommand searches for the provided albums.
+func AlbumSearchCommand(albumIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "AlbumSearch", AlbumIDs: albumIDs}
+}
+
+// ArtistSearchCommand searches for every monitored album by an artist.
+func ArtistSearchCommand(artistID int64) *CommandRequest {
+	return &CommandRequest{Name: "ArtistSearch", ArtistID: artistID}
+}
+
+// MissingAlbumSearchCommand searches for all missing monitored albums.
+func MissingAlbumSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingAlbumSearch"}
+}
+
+// CutoffUnmetAlbumSearchCommand searches for upgrades to all albums that have not met their quality cutoff.
+func CutoffUnmetAlbumSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetAlbumSearch"}
+}
+
+// RefreshArtistCommand refreshes metadata and rescans files for an artist. Use 0 to refresh every artist.
+func RefreshArtistCommand(artistID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshArtist", ArtistID: artistID}
+}
+
+// RefreshAlbumCommand refreshes metadata for a single album.
+func RefreshAlbumCommand(albumID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshAlbum", AlbumID: albumID}
+}
+
+// RescanFoldersCommand rescans the provided root folders. Provide no folders to rescan all of them.
+func RescanFoldersCommand(folders ...string) *CommandRequest {
+	return &CommandRequest{Name: "RescanFolders", Folders: folders}
+}
+
+// RenameFilesCommand renames the provided track files that belong to an artist.
+func RenameFilesCommand(artistID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", ArtistID: artistID, Files: fileIDs}
+}
+
+// RenameArtistCommand renames every track file by the provided artists.
+func RenameArtistCommand(artistIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameArtist", ArtistIDs: artistIDs}
+}
+
+// DownloadedAlbumsScanCommand imports the completed downloads found in a folder.
+func DownloadedAlbumsScanCommand(path string) *CommandRequest {
+	return &CommandRequest{Name: "DownloadedAlbumsScan", Path: path}
+}
+
+// RssSyncCommand checks every RSS enabled indexer for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks the download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. See CreateBackup for a method that waits for it.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: starr.BackupCommand}
+}
+
+// CheckHealthCommand runs all health checks.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// HousekeepingCommand runs the database housekeeping tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
+
+// ClearBlocklistCommand removes every item from the block list.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand deletes old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
@@ -0,0 +1,28 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpHealth = APIver + "/health"
+
+// GetHealth returns all active health check problems from the /api/v1/health endpoint.
+// An empty list means Lidarr found no problems with itself.
+func (l *Lidarr) GetHealth() ([]*starr.HealthCheck, error) {
+	return l.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns all active health check problems from the /api/v1/health endpoint.
+func (l *Lidarr) GetHealthContext(ctx context.Context) ([]*starr.HealthCheck, error) {
+	var output []*starr.HealthCheck
+
+	req := starr.Request{URI: bpHealth}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
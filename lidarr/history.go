@@ -117,6 +117,19 @@ func (l *Lidarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// HistoryPager returns a pager to walk the Lidarr History (grabs/failures/completed) one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (l *Lidarr) HistoryPager(params *starr.PageReq) *starr.Pager[*HistoryRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := l.GetHistoryPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (l *Lidarr) Fail(historyID int64) error {
 	return l.FailContext(context.Background(), historyID)
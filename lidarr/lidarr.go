@@ -2,6 +2,7 @@ package lidarr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -65,3 +66,17 @@ func (l *Lidarr) PingContext(ctx context.Context) error {
 
 	return nil
 }
+
+// convert copies an output type into its matching input type, through JSON.
+func convert(input, output interface{}) error {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("json.Marshal(%T): %w", input, err)
+	}
+
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("json.Unmarshal(%T): %w", output, err)
+	}
+
+	return nil
+}
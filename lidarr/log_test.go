@@ -0,0 +1,139 @@
+package lidarr_test
+
+import (
+	"bytes"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLogs(t *testing.T) {
+	t.Parallel()
+
+	logTime := time.Date(2024, 3, 2, 1, 0, 0, 0, time.UTC)
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "log") +
+				"?level=error&page=1&pageSize=10&sortDirection=descending&sortKey=time",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"time","sortDirection":"descending","totalRecords":1,` +
+				`"records":[{"id":9,"time":"2024-03-02T01:00:00Z","level":"error","logger":"DownloadMonitoringService",` +
+				`"message":"Import failed, path does not exist"}]}`,
+			WithRequest: &starr.PageReq{},
+			WithResponse: &starr.Logs{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "time",
+				SortDirection: "descending",
+				TotalRecords:  1,
+				Records: []*starr.LogRecord{{
+					ID:      9,
+					Time:    logTime,
+					Level:   "error",
+					Logger:  "DownloadMonitoringService",
+					Message: "Import failed, path does not exist",
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "log") +
+				"?level=error&page=1&pageSize=10&sortDirection=descending&sortKey=time",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*starr.Logs)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			params, _ := test.WithRequest.(*starr.PageReq)
+			params.Set("level", "error")
+			output, err := client.GetLogs(params)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetLogFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "log", "file"),
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":1,"filename":"lidarr.txt","lastWriteTime":"2024-03-02T01:00:00Z",` +
+				`"contentsUrl":"/api/log/file/lidarr.txt","downloadUrl":"/logfile/lidarr.txt"}]`,
+			WithResponse: []*starr.LogFile{{
+				ID:            1,
+				Filename:      "lidarr.txt",
+				LastWriteTime: time.Date(2024, 3, 2, 1, 0, 0, 0, time.UTC),
+				ContentsURL:   "/api/log/file/lidarr.txt",
+				DownloadURL:   "/logfile/lidarr.txt",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "log", "file"),
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.LogFile(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetLogFiles()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDownloadLogFile(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "log", "file", "lidarr.txt"),
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   "2024-03-02 01:00:00.0|Info|Bootstrap|Starting\n",
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	var buf bytes.Buffer
+
+	size, err := client.DownloadLogFile("../lidarr.txt", &buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(test.ResponseBody), size)
+	assert.Equal(t, test.ResponseBody, buf.String())
+}
@@ -0,0 +1,69 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "parse") + "?title=Artist-Album-FLAC-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"title":"Artist-Album-FLAC-GRP","artist":{"id":2,"artistName":"Artist"},` +
+				`"albums":[{"id":5,"title":"Album","artistId":2}],` +
+				`"parsedAlbumInfo":{"albumTitle":"Album","artistName":"Artist","releaseGroup":"GRP"}}`,
+			WithRequest: "Artist-Album-FLAC-GRP",
+			WithResponse: &lidarr.ParseOutput{
+				Title:           "Artist-Album-FLAC-GRP",
+				Artist:          &lidarr.Artist{ID: 2, ArtistName: "Artist"},
+				Albums:          []*lidarr.Album{{ID: 5, Title: "Album", ArtistID: 2}},
+				ParsedAlbumInfo: &lidarr.ParsedAlbumInfo{AlbumTitle: "Album", ArtistName: "Artist", ReleaseGroup: "GRP"},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "200 not parsed",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "parse") + "?title=not-a-release",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"title":"not-a-release"}`,
+			WithRequest:    "not-a-release",
+			WithResponse:   &lidarr.ParseOutput{Title: "not-a-release"},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "parse") + "?title=Artist-Album-FLAC-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    "Artist-Album-FLAC-GRP",
+			WithResponse:   (*lidarr.ParseOutput)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse(test.WithRequest.(string))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
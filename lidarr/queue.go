@@ -109,6 +109,19 @@ func (l *Lidarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// QueuePager returns a pager to walk the Lidarr Queue one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (l *Lidarr) QueuePager(params *starr.PageReq) *starr.Pager[*QueueRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*QueueRecord, int, error) {
+		page, err := l.GetQueuePageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (l *Lidarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return l.DeleteQueueContext(context.Background(), queueID, opts)
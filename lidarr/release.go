@@ -0,0 +1,200 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+const (
+	bpRelease     = APIver + "/release"
+	bpReleasePush = bpRelease + "/push"
+)
+
+// IndexerRelease is the output from the Lidarr release endpoint.
+// It is named IndexerRelease because Release is part of an Album.
+type IndexerRelease struct {
+	ID                  int64                 `json:"id"`
+	GUID                string                `json:"guid"`
+	Quality             *starr.Quality        `json:"quality"`
+	CustomFormats       []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore   int64                 `json:"customFormatScore"`
+	QualityWeight       int64                 `json:"qualityWeight"`
+	Age                 int64                 `json:"age"`
+	AgeHours            float64               `json:"ageHours"`
+	AgeMinutes          float64               `json:"ageMinutes"`
+	Size                int64                 `json:"size"`
+	IndexerID           int64                 `json:"indexerId"`
+	Indexer             string                `json:"indexer"`
+	ReleaseGroup        string                `json:"releaseGroup"`
+	ReleaseHash         string                `json:"releaseHash"`
+	Title               string                `json:"title"`
+	Discography         bool                  `json:"discography"`
+	SceneSource         bool                  `json:"sceneSource"`
+	AirDate             string                `json:"airDate"`
+	ArtistName          string                `json:"artistName"`
+	AlbumTitle          string                `json:"albumTitle"`
+	Approved            bool                  `json:"approved"`
+	TemporarilyRejected bool                  `json:"temporarilyRejected"`
+	Rejected            bool                  `json:"rejected"`
+	Rejections          []string              `json:"rejections"`
+	PublishDate         time.Time             `json:"publishDate"`
+	CommentURL          string                `json:"commentUrl"`
+	DownloadURL         string                `json:"downloadUrl"`
+	InfoURL             string                `json:"infoUrl"`
+	DownloadAllowed     bool                  `json:"downloadAllowed"`
+	ReleaseWeight       int64                 `json:"releaseWeight"`
+	MagnetURL           string                `json:"magnetUrl"`
+	InfoHash            string                `json:"infoHash"`
+	Seeders             int                   `json:"seeders"`
+	Leechers            int                   `json:"leechers"`
+	Protocol            starr.Protocol        `json:"protocol"`
+	IndexerFlags        int64                 `json:"indexerFlags"`
+	ArtistID            int64                 `json:"artistId"`
+	AlbumID             int64                 `json:"albumId"`
+	DownloadClientID    int64                 `json:"downloadClientId"`
+	DownloadClient      string                `json:"downloadClient"`
+}
+
+// SearchRelease is the input needed to search for releases through Lidarr.
+type SearchRelease struct {
+	ArtistID int64 `json:"artistId"`
+	AlbumID  int64 `json:"albumId"`
+}
+
+// SearchRelease searches for and returns a list releases available for download.
+func (l *Lidarr) SearchRelease(input *SearchRelease) ([]*IndexerRelease, error) {
+	return l.SearchReleaseContext(context.Background(), input)
+}
+
+// SearchReleaseContext searches for and returns a list releases available for download.
+// Only the IDs that are not zero are sent.
+func (l *Lidarr) SearchReleaseContext(ctx context.Context, input *SearchRelease) ([]*IndexerRelease, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+
+	if input.ArtistID != 0 {
+		req.Query.Set("artistId", starr.Str(input.ArtistID))
+	}
+
+	if input.AlbumID != 0 {
+		req.Query.Set("albumId", starr.Str(input.AlbumID))
+	}
+
+	var output []*IndexerRelease
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Grab is the output from the Grab* methods.
+type Grab struct {
+	Approved            bool           `json:"approved"`
+	DownloadAllowed     bool           `json:"downloadAllowed"`
+	Discography         bool           `json:"discography"`
+	TemporarilyRejected bool           `json:"temporarilyRejected"`
+	Rejected            bool           `json:"rejected"`
+	SceneSource         bool           `json:"sceneSource"`
+	AgeHours            float64        `json:"ageHours"`
+	AgeMinutes          float64        `json:"ageMinutes"`
+	Size                int64          `json:"size"`
+	Age                 int64          `json:"age"`
+	CustomFormatScore   int64          `json:"customFormatScore"`
+	IndexerFlags        int64          `json:"indexerFlags"`
+	IndexerID           int64          `json:"indexerId"`
+	QualityWeight       int64          `json:"qualityWeight"`
+	ReleaseWeight       int64          `json:"releaseWeight"`
+	PublishDate         time.Time      `json:"publishDate"`
+	GUID                string         `json:"guid"`
+	Protocol            starr.Protocol `json:"protocol"`
+}
+
+// Grab adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (l *Lidarr) Grab(guid string, indexerID int64) (*Grab, error) {
+	return l.GrabContext(context.Background(), guid, indexerID)
+}
+
+// GrabContext adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (l *Lidarr) GrabContext(ctx context.Context, guid string, indexerID int64) (*Grab, error) {
+	return l.GrabReleaseContext(ctx, &IndexerRelease{IndexerID: indexerID, GUID: guid})
+}
+
+// GrabRelease adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (l *Lidarr) GrabRelease(release *IndexerRelease) (*Grab, error) {
+	return l.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (l *Lidarr) GrabReleaseContext(ctx context.Context, release *IndexerRelease) (*Grab, error) {
+	grab := struct { // We only use/need the guid and indexerID from the release.
+		G string `json:"guid"`
+		I int64  `json:"indexerId"`
+	}{G: release.GUID, I: release.IndexerID}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&grab); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output Grab
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease is the input needed to push a release from an external source into Lidarr.
+type PushRelease struct {
+	Title       string         `json:"title"`
+	DownloadURL string         `json:"downloadUrl"`
+	Protocol    starr.Protocol `json:"protocol"`
+	PublishDate time.Time      `json:"publishDate"`
+	Size        int64          `json:"size"`
+	Indexer     string         `json:"indexer"`
+}
+
+// PushRelease sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (l *Lidarr) PushRelease(input *PushRelease) ([]*IndexerRelease, error) {
+	return l.PushReleaseContext(context.Background(), input)
+}
+
+// PushReleaseContext sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (l *Lidarr) PushReleaseContext(ctx context.Context, input *PushRelease) ([]*IndexerRelease, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleasePush, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: bpReleasePush, Body: &body}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	// Newer versions return a list of decisions, older versions return only one.
+	var decisions []*IndexerRelease
+	if err := json.Unmarshal(output, &decisions); err == nil {
+		return decisions, nil
+	}
+
+	var decision IndexerRelease
+	if err := json.Unmarshal(output, &decision); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(%s): %w", bpReleasePush, err)
+	}
+
+	return []*IndexerRelease{&decision}, nil
+}
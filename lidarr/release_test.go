@@ -0,0 +1,196 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release") + "?albumId=5&artistId=2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid":"abc","indexerId":3,"indexer":"Tracker","title":"Artist-Album-FLAC",` +
+				`"customFormatScore":150,"seeders":40,"rejected":true,"rejections":["Not an upgrade"],` +
+				`"artistId":2,"albumId":5}]`,
+			WithRequest: &lidarr.SearchRelease{ArtistID: 2, AlbumID: 5},
+			WithResponse: []*lidarr.IndexerRelease{{
+				GUID:              "abc",
+				IndexerID:         3,
+				Indexer:           "Tracker",
+				Title:             "Artist-Album-FLAC",
+				CustomFormatScore: 150,
+				Seeders:           40,
+				Rejected:          true,
+				Rejections:        []string{"Not an upgrade"},
+				ArtistID:          2,
+				AlbumID:           5,
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "200 album only",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release") + "?albumId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[]`,
+			WithRequest:    &lidarr.SearchRelease{AlbumID: 5},
+			WithResponse:   []*lidarr.IndexerRelease{},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release") + "?artistId=2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &lidarr.SearchRelease{ArtistID: 2},
+			WithResponse:   []*lidarr.IndexerRelease(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*lidarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrab(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.Grab("abc", 3)
+	require.NoError(t, err)
+	assert.Equal(t, &lidarr.Grab{GUID: "abc", IndexerID: 3, Approved: true}, output)
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true,"downloadAllowed":true}`,
+			WithRequest:     &lidarr.IndexerRelease{GUID: "abc", IndexerID: 3, Title: "Artist-Album-FLAC", AlbumID: 5},
+			WithResponse:    &lidarr.Grab{GUID: "abc", IndexerID: 3, Approved: true, DownloadAllowed: true},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithRequest:     &lidarr.IndexerRelease{GUID: "abc", IndexerID: 3},
+			WithResponse:    (*lidarr.Grab)(nil),
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GrabRelease(test.WithRequest.(*lidarr.IndexerRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, 5, 6, 7, 0, 0, 0, time.UTC)
+	request := `{"title":"Artist-Album-FLAC-GRP","downloadUrl":"http://rss/1.torrent","protocol":"torrent",` +
+		`"publishDate":"2024-05-06T07:00:00Z","size":1000,"indexer":"Scraper"}` + "\n"
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `[{"title":"Artist-Album-FLAC-GRP","approved":false,"rejections":["Not an upgrade"]}]`,
+			WithResponse: []*lidarr.IndexerRelease{
+				{Title: "Artist-Album-FLAC-GRP", Rejections: []string{"Not an upgrade"}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "single",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"title":"Artist-Album-FLAC-GRP","approved":true}`,
+			WithResponse:    []*lidarr.IndexerRelease{{Title: "Artist-Album-FLAC-GRP", Approved: true}},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithResponse:    []*lidarr.IndexerRelease(nil),
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.PushRelease(&lidarr.PushRelease{
+				Title:       "Artist-Album-FLAC-GRP",
+				DownloadURL: "http://rss/1.torrent",
+				Protocol:    starr.ProtocolTorrent,
+				PublishDate: published,
+				Size:        1000,
+				Indexer:     "Scraper",
+			})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
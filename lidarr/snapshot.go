@@ -0,0 +1,319 @@
+package lidarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BSFishy/starr"
+)
+
+// Snapshot is a copy of the settings in a Lidarr instance. Create one with GetSnapshot or
+// ExportSnapshot, and copy it into another instance with ImportSnapshot.
+// Indexers, download clients, notifications, root folders and metadata profiles are not included.
+type Snapshot struct {
+	starr.SnapshotHeader
+	Tags                 []*starr.Tag               `json:"tags"`
+	Naming               *Naming                    `json:"naming"`
+	MediaManagement      *MediaManagement           `json:"mediaManagement"`
+	IndexerConfig        *IndexerConfig             `json:"indexerConfig"`
+	DownloadClientConfig *DownloadClientConfig      `json:"downloadClientConfig"`
+	QualityDefinitions   []*QualityDefinition       `json:"qualityDefinitions"`
+	CustomFormats        []*CustomFormatOutput      `json:"customFormats"`
+	QualityProfiles      []*QualityProfile          `json:"qualityProfiles"`
+	RemotePathMappings   []*starr.RemotePathMapping `json:"remotePathMappings"`
+}
+
+// GetSnapshot returns a copy of the settings in Lidarr.
+func (l *Lidarr) GetSnapshot() (*Snapshot, error) {
+	return l.GetSnapshotContext(context.Background())
+}
+
+// GetSnapshotContext returns a copy of the settings in Lidarr.
+func (l *Lidarr) GetSnapshotContext(ctx context.Context) (*Snapshot, error) {
+	var (
+		snap = &Snapshot{SnapshotHeader: starr.NewSnapshotHeader(starr.Lidarr)}
+		err  error
+	)
+
+	if snap.Tags, err = l.GetTagsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.Naming, err = l.GetNamingContext(ctx); err != nil {
+		return nil, err
+	} else if snap.MediaManagement, err = l.GetMediaManagementContext(ctx); err != nil {
+		return nil, err
+	} else if snap.IndexerConfig, err = l.GetIndexerConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.DownloadClientConfig, err = l.GetDownloadClientConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityDefinitions, err = l.GetQualityDefinitionsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.CustomFormats, err = l.GetCustomFormatsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityProfiles, err = l.GetQualityProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.RemotePathMappings, err = l.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// ExportSnapshot writes a JSON snapshot of the settings in Lidarr.
+func (l *Lidarr) ExportSnapshot(output io.Writer) error {
+	return l.ExportSnapshotContext(context.Background(), output)
+}
+
+// ExportSnapshotContext writes a JSON snapshot of the settings in Lidarr.
+func (l *Lidarr) ExportSnapshotContext(ctx context.Context, output io.Writer) error {
+	snap, err := l.GetSnapshotContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snap); err != nil {
+		return fmt.Errorf("json.Marshal(snapshot): %w", err)
+	}
+
+	return nil
+}
+
+// ImportSnapshot reads a JSON snapshot, and applies it to Lidarr with ApplySnapshot.
+func (l *Lidarr) ImportSnapshot(input io.Reader) error {
+	return l.ImportSnapshotContext(context.Background(), input)
+}
+
+// ImportSnapshotContext reads a JSON snapshot, and applies it to Lidarr with ApplySnapshot.
+func (l *Lidarr) ImportSnapshotContext(ctx context.Context, input io.Reader) error {
+	var snap Snapshot
+	if err := json.NewDecoder(input).Decode(&snap); err != nil {
+		return fmt.Errorf("json.Unmarshal(snapshot): %w", err)
+	}
+
+	return l.ApplySnapshotContext(ctx, &snap)
+}
+
+// ApplySnapshot copies the settings in a snapshot into Lidarr.
+func (l *Lidarr) ApplySnapshot(snap *Snapshot) error {
+	return l.ApplySnapshotContext(context.Background(), snap)
+}
+
+// ApplySnapshotContext copies the settings in a snapshot into Lidarr. Tags, custom formats and
+// quality profiles are matched by name, and created when missing. IDs are remapped to the IDs in this
+// instance. Stops at the first error.
+func (l *Lidarr) ApplySnapshotContext(ctx context.Context, snap *Snapshot) error {
+	if err := snap.Check(starr.Lidarr); err != nil {
+		return err
+	}
+
+	existing, err := l.GetTagsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := starr.ImportTags(ctx, existing, snap.Tags, l.AddTagContext); err != nil {
+		return err
+	}
+
+	if err := l.applySnapshotConfig(ctx, snap); err != nil {
+		return err
+	}
+
+	formats, err := l.applySnapshotFormats(ctx, snap.CustomFormats)
+	if err != nil {
+		return err
+	}
+
+	if err := l.applySnapshotProfiles(ctx, snap.QualityProfiles, formats); err != nil {
+		return err
+	}
+
+	return l.applySnapshotMappings(ctx, snap.RemotePathMappings)
+}
+
+// applySnapshotConfig updates the settings that exist once per instance.
+func (l *Lidarr) applySnapshotConfig(ctx context.Context, snap *Snapshot) error {
+	if snap.Naming != nil {
+		if _, err := l.UpdateNamingContext(ctx, snap.Naming); err != nil {
+			return fmt.Errorf("importing naming: %w", err)
+		}
+	}
+
+	if snap.MediaManagement != nil {
+		if _, err := l.UpdateMediaManagementContext(ctx, snap.MediaManagement); err != nil {
+			return fmt.Errorf("importing media management: %w", err)
+		}
+	}
+
+	if snap.IndexerConfig != nil {
+		if _, err := l.UpdateIndexerConfigContext(ctx, snap.IndexerConfig); err != nil {
+			return fmt.Errorf("importing indexer config: %w", err)
+		}
+	}
+
+	if snap.DownloadClientConfig != nil {
+		if _, err := l.UpdateDownloadClientConfigContext(ctx, snap.DownloadClientConfig); err != nil {
+			return fmt.Errorf("importing download client config: %w", err)
+		}
+	}
+
+	if len(snap.QualityDefinitions) == 0 {
+		return nil
+	}
+
+	definitions, err := l.GetQualityDefinitionsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Quality IDs are the same in every instance, but definition IDs may not be.
+	ids := make(map[int64]int64, len(definitions))
+	for _, def := range definitions {
+		if def.Quality != nil {
+			ids[def.Quality.ID] = def.ID
+		}
+	}
+
+	update := []*QualityDefinition{}
+
+	for _, def := range snap.QualityDefinitions {
+		if def.Quality == nil {
+			continue
+		}
+
+		if id, ok := ids[def.Quality.ID]; ok {
+			copied := *def
+			copied.ID = id
+			update = append(update, &copied)
+		}
+	}
+
+	if _, err := l.UpdateQualityDefinitionsContext(ctx, update); err != nil {
+		return fmt.Errorf("importing quality definitions: %w", err)
+	}
+
+	return nil
+}
+
+// applySnapshotFormats creates or updates custom formats by name, and returns a map of snapshot IDs to new IDs.
+func (l *Lidarr) applySnapshotFormats(ctx context.Context, formats []*CustomFormatOutput) (map[int64]int64, error) {
+	existing, err := l.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]int64, len(existing))
+	for _, format := range existing {
+		names[strings.ToLower(format.Name)] = format.ID
+	}
+
+	ids := make(map[int64]int64, len(formats))
+
+	for _, format := range formats {
+		var input CustomFormatInput
+		if err := convert(format, &input); err != nil {
+			return nil, err
+		}
+
+		input.ID = names[strings.ToLower(format.Name)]
+
+		var output *CustomFormatOutput
+		if input.ID == 0 {
+			output, err = l.AddCustomFormatContext(ctx, &input)
+		} else {
+			output, err = l.UpdateCustomFormatContext(ctx, &input)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("importing custom format '%s': %w", format.Name, err)
+		}
+
+		ids[format.ID] = output.ID
+	}
+
+	return ids, nil
+}
+
+// applySnapshotProfiles creates or updates quality profiles by name, with their format scores remapped.
+// Every custom format in the instance is listed in each profile; formats not in the snapshot keep their scores.
+func (l *Lidarr) applySnapshotProfiles(ctx context.Context, profiles []*QualityProfile, formats map[int64]int64) error {
+	existing, err := l.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customFormats, err := l.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := make([]*starr.Value, len(customFormats))
+	for idx, format := range customFormats {
+		values[idx] = &starr.Value{ID: format.ID, Name: format.Name}
+	}
+
+	names := make(map[string]*QualityProfile, len(existing))
+	for _, profile := range existing {
+		names[strings.ToLower(profile.Name)] = profile
+	}
+
+	for _, profile := range profiles {
+		var have []*starr.FormatItem
+
+		copied := *profile
+		copied.ID = 0
+
+		if found := names[strings.ToLower(profile.Name)]; found != nil {
+			copied.ID, have = found.ID, found.FormatItems
+		}
+
+		copied.FormatItems = starr.RemapFormatItems(values, have, profile.FormatItems, formats)
+
+		if copied.ID == 0 {
+			_, err = l.AddQualityProfileContext(ctx, &copied)
+		} else {
+			_, err = l.UpdateQualityProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing quality profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotMappings creates or updates remote path mappings, matched by host and remote path.
+func (l *Lidarr) applySnapshotMappings(ctx context.Context, mappings []*starr.RemotePathMapping) error {
+	existing, err := l.GetRemotePathMappingsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]int64, len(existing))
+	for _, mapping := range existing {
+		ids[mapping.Host+mapping.RemotePath] = mapping.ID
+	}
+
+	for _, mapping := range mappings {
+		copied := *mapping
+		copied.ID = ids[mapping.Host+mapping.RemotePath]
+
+		if copied.ID == 0 {
+			_, err = l.AddRemotePathMappingContext(ctx, &copied)
+		} else {
+			_, err = l.UpdateRemotePathMappingContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing remote path mapping '%s': %w", mapping.RemotePath, err)
+		}
+	}
+
+	return nil
+}
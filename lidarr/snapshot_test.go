@@ -0,0 +1,110 @@
+package lidarr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotBody returns the request body a client sends for input.
+func snapshotBody(t *testing.T, input interface{}) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(input))
+
+	return body.String()
+}
+
+func snapshotMock(method, uri, request, response string) *starrtest.MockData {
+	return &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, uri),
+		ExpectedMethod:  method,
+		ExpectedRequest: request,
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    response,
+	}
+}
+
+func snapshotGet(uri, response string) *starrtest.MockData {
+	return snapshotMock(http.MethodGet, uri, "", response)
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	source := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":1,"label":"flac"}]`),
+		snapshotGet("config/naming", `{"id":1,"renameTracks":true}`),
+		snapshotGet("config/mediaManagement", `{"id":1}`),
+		snapshotGet("config/indexer", `{"id":1}`),
+		snapshotGet("config/downloadClient", `{"id":1}`),
+		snapshotGet("qualitydefinition", `[{"id":1,"quality":{"id":6,"name":"FLAC"},"title":"FLAC","maxSize":100}]`),
+		snapshotGet("customFormat", `[{"id":8,"name":"Lossless"}]`),
+		snapshotGet("qualityProfile", `[{"id":1,"name":"Lossless","formatItems":[{"format":8,"name":"Lossless","score":50}]}]`),
+		snapshotGet("remotePathMapping", `[{"id":1,"host":"box","remotePath":"/dl/","localPath":"/data/"}]`),
+	)
+
+	var output bytes.Buffer
+
+	require.NoError(t, lidarr.New(starr.New("mockAPIkey", source.URL, 0)).ExportSnapshot(&output))
+
+	var snap lidarr.Snapshot
+	require.NoError(t, json.Unmarshal(output.Bytes(), &snap))
+	assert.Equal(t, starr.Lidarr, snap.App)
+	assert.True(t, snap.Naming.RenameTracks)
+
+	target := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[]`),
+		snapshotMock(http.MethodPost, "tag", snapshotBody(t, &starr.Tag{Label: "flac"}), `{"id":4,"label":"flac"}`),
+		snapshotMock(http.MethodPut, "config/naming", snapshotBody(t, snap.Naming), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/mediaManagement", snapshotBody(t, snap.MediaManagement), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/indexer/1", snapshotBody(t, snap.IndexerConfig), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/downloadClient/1", snapshotBody(t, snap.DownloadClientConfig), `{"id":1}`),
+		snapshotGet("qualitydefinition", `[{"id":3,"quality":{"id":6,"name":"FLAC"}}]`),
+		snapshotMock(http.MethodPut, "qualitydefinition/update", snapshotBody(t, []*lidarr.QualityDefinition{{
+			ID:      3,
+			Quality: &starr.Value{ID: 6, Name: "FLAC"},
+			Title:   "FLAC",
+			MaxSize: 100,
+		}}), `[]`),
+		snapshotGet("customFormat", `[{"id":2,"name":"lossless"}]`),
+		snapshotMock(http.MethodPut, "customFormat/2",
+			snapshotBody(t, &lidarr.CustomFormatInput{ID: 2, Name: "Lossless"}), `{"id":2,"name":"Lossless"}`),
+		snapshotGet("qualityProfile", `[{"id":7,"name":"Lossless","formatItems":[`+
+			`{"format":2,"name":"Lossless","score":0},{"format":5,"name":"Scene","score":-20}]}]`),
+		snapshotGet("customFormat", `[{"id":2,"name":"Lossless"},{"id":5,"name":"Scene"}]`),
+		snapshotMock(http.MethodPut, "qualityProfile/7", snapshotBody(t, &lidarr.QualityProfile{
+			ID:   7,
+			Name: "Lossless",
+			FormatItems: []*starr.FormatItem{
+				{Format: 2, Name: "Lossless", Score: 50}, // remapped from format 8.
+				{Format: 5, Name: "Scene", Score: -20},   // not in the snapshot, so the score is kept.
+			},
+		}), `{"id":7}`),
+		snapshotGet("remotePathMapping", `[]`),
+		snapshotMock(http.MethodPost, "remotePathMapping",
+			snapshotBody(t, &starr.RemotePathMapping{Host: "box", RemotePath: "/dl/", LocalPath: "/data/"}), `{"id":1}`),
+	)
+
+	client := lidarr.New(starr.New("mockAPIkey", target.URL, 0))
+	require.NoError(t, client.ImportSnapshot(&output))
+}
+
+func TestImportSnapshotWrongApp(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t) // no requests may be made.
+	client := lidarr.New(starr.New("mockAPIkey", server.URL, 0))
+	err := client.ImportSnapshot(strings.NewReader(`{"version":1,"app":"Whisparr"}`))
+	require.ErrorIs(t, err, starr.ErrSnapshotApp)
+}
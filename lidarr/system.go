@@ -61,13 +61,13 @@ func (l *Lidarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, err
 }
 
 // GetBackupFiles returns all available Lidarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (l *Lidarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return l.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Lidarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (l *Lidarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
@@ -0,0 +1,145 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpWanted = APIver + "/wanted"
+
+// Wanted is the data from the /api/v1/wanted/missing and /api/v1/wanted/cutoff endpoints.
+type Wanted struct {
+	Page          int      `json:"page"`
+	PageSize      int      `json:"pageSize"`
+	SortKey       string   `json:"sortKey"`
+	SortDirection string   `json:"sortDirection"`
+	TotalRecords  int      `json:"totalRecords"`
+	Records       []*Album `json:"records"`
+}
+
+// GetWantedMissing returns Albums that are missing a file (the Wanted Missing page).
+// If monitored is true, only monitored Albums are returned, otherwise only unmonitored Albums are returned.
+// This function simply returns the number of records desired, up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates them into one list.
+// Passing zero for records will return all of them.
+func (l *Lidarr) GetWantedMissing(records, perPage int, monitored bool) (*Wanted, error) {
+	return l.GetWantedMissingContext(context.Background(), records, perPage, monitored)
+}
+
+// GetWantedMissingContext returns Albums that are missing a file. See GetWantedMissing for more.
+func (l *Lidarr) GetWantedMissingContext(ctx context.Context, records, perPage int, monitored bool) (*Wanted, error) {
+	return l.getWantedAll(ctx, "missing", records, perPage, monitored)
+}
+
+// GetWantedMissingPage returns a single page of Albums that are missing a file.
+// The page size and number is configurable with the input request parameters.
+func (l *Lidarr) GetWantedMissingPage(params *starr.PageReq, monitored bool) (*Wanted, error) {
+	return l.GetWantedMissingPageContext(context.Background(), params, monitored)
+}
+
+// GetWantedMissingPageContext returns a single page of Albums that are missing a file.
+// The page size and number is configurable with the input request parameters.
+func (l *Lidarr) GetWantedMissingPageContext(ctx context.Context, params *starr.PageReq, monitored bool) (*Wanted, error) {
+	return l.getWantedPage(ctx, "missing", params, monitored)
+}
+
+// WantedMissingPager returns a pager to walk the Albums that are missing a file one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (l *Lidarr) WantedMissingPager(params *starr.PageReq, monitored bool) *starr.Pager[*Album] {
+	return l.wantedPager("missing", params, monitored)
+}
+
+// GetWantedCutoff returns Albums that have a file that does not meet the quality cutoff (the Wanted Cutoff Unmet page).
+// If monitored is true, only monitored Albums are returned, otherwise only unmonitored Albums are returned.
+// Records are collected in batches of perPage, like GetWantedMissing. Passing zero for records will return all of them.
+func (l *Lidarr) GetWantedCutoff(records, perPage int, monitored bool) (*Wanted, error) {
+	return l.GetWantedCutoffContext(context.Background(), records, perPage, monitored)
+}
+
+// GetWantedCutoffContext returns Albums that do not meet the quality cutoff. See GetWantedCutoff for more.
+func (l *Lidarr) GetWantedCutoffContext(ctx context.Context, records, perPage int, monitored bool) (*Wanted, error) {
+	return l.getWantedAll(ctx, "cutoff", records, perPage, monitored)
+}
+
+// GetWantedCutoffPage returns a single page of Albums that do not meet the quality cutoff.
+// The page size and number is configurable with the input request parameters.
+func (l *Lidarr) GetWantedCutoffPage(params *starr.PageReq, monitored bool) (*Wanted, error) {
+	return l.GetWantedCutoffPageContext(context.Background(), params, monitored)
+}
+
+// GetWantedCutoffPageContext returns a single page of Albums that do not meet the quality cutoff.
+// The page size and number is configurable with the input request parameters.
+func (l *Lidarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.PageReq, monitored bool) (*Wanted, error) {
+	return l.getWantedPage(ctx, "cutoff", params, monitored)
+}
+
+// WantedCutoffPager returns a pager to walk the Albums that do not meet the quality cutoff one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (l *Lidarr) WantedCutoffPager(params *starr.PageReq, monitored bool) *starr.Pager[*Album] {
+	return l.wantedPager("cutoff", params, monitored)
+}
+
+// getWantedAll collects records from a wanted list in batches of perPage, like GetHistory.
+func (l *Lidarr) getWantedAll(ctx context.Context, list string, records, perPage int, monitored bool) (*Wanted, error) {
+	wanted := &Wanted{Records: []*Album{}}
+	perPage = starr.SetPerPage(records, perPage)
+
+	for page := 1; ; page++ {
+		curr, err := l.getWantedPage(ctx, list, &starr.PageReq{PageSize: perPage, Page: page}, monitored)
+		if err != nil {
+			return nil, err
+		}
+
+		wanted.Records = append(wanted.Records, curr.Records...)
+
+		if len(wanted.Records) >= curr.TotalRecords ||
+			(len(wanted.Records) >= records && records != 0) ||
+			len(curr.Records) == 0 {
+			wanted.PageSize = curr.TotalRecords
+			wanted.TotalRecords = curr.TotalRecords
+			wanted.SortDirection = curr.SortDirection
+			wanted.SortKey = curr.SortKey
+
+			break
+		}
+
+		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(wanted.Records), perPage)
+	}
+
+	return wanted, nil
+}
+
+func (l *Lidarr) getWantedPage(ctx context.Context, list string, params *starr.PageReq, monitored bool) (*Wanted, error) {
+	var output Wanted
+
+	if params == nil {
+		params = &starr.PageReq{}
+	}
+
+	params.CheckSet("sortKey", "albums.releaseDate")
+	params.CheckSet("includeArtist", "true")
+
+	query := params.Params()
+	query.Set("monitored", starr.Str(monitored))
+
+	req := starr.Request{URI: path.Join(bpWanted, list), Query: query}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+func (l *Lidarr) wantedPager(list string, params *starr.PageReq, monitored bool) *starr.Pager[*Album] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*Album, int, error) {
+		page, err := l.getWantedPage(ctx, list, params, monitored)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
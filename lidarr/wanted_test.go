@@ -0,0 +1,101 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "wanted", "missing") +
+				"?includeArtist=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=albums.releaseDate",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"albums.releaseDate","sortDirection":"ascending",` +
+				`"totalRecords":1,"records":[{"id":7,"title":"Album","artistId":2,"monitored":true}]}`,
+			WithRequest: &starr.PageReq{},
+			WithResponse: &lidarr.Wanted{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "albums.releaseDate",
+				SortDirection: "ascending",
+				TotalRecords:  1,
+				Records:       []*lidarr.Album{{ID: 7, Title: "Album", ArtistID: 2, Monitored: true}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "wanted", "missing") +
+				"?includeArtist=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=albums.releaseDate",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*lidarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			params, _ := test.WithRequest.(*starr.PageReq)
+			output, err := client.GetWantedMissingPage(params, true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetWantedCutoff(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "wanted", "cutoff") +
+			"?includeArtist=true&monitored=false&page=1&pageSize=2&sortDirection=ascending&sortKey=albums.releaseDate",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `{"page":1,"pageSize":2,"sortKey":"albums.releaseDate","sortDirection":"ascending",` +
+			`"totalRecords":2,"records":[{"id":1},{"id":2}]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoff(2, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.TotalRecords)
+	assert.Len(t, output.Records, 2)
+}
+
+func TestGetWantedCutoffPageNil(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "wanted", "cutoff") +
+			"?includeArtist=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=albums.releaseDate",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"page":1,"pageSize":10,"totalRecords":0,"records":[]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoffPage(nil, true)
+	require.NoError(t, err, "nil params must use the defaults")
+	assert.Empty(t, output.Records)
+}
@@ -0,0 +1,128 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+/* This file contains an optional client-side rate limiter for a starr.Config. */
+
+// Limiter restricts how fast, and how many concurrent, requests are sent to a Starr app.
+// Attach one to a starr.Config to throttle every request made through it; this protects
+// small instances from being overwhelmed by fan-out calls. Create one with NewLimiter.
+// A Limiter may be shared by multiple Configs that point to the same instance.
+type Limiter struct {
+	rate     float64       // tokens added per second. 0 = unlimited.
+	burst    float64       // maximum tokens in the bucket.
+	inFlight chan struct{} // semaphore for concurrent requests. nil = unlimited.
+	mu       sync.Mutex
+	tokens   float64
+	last     time.Time
+}
+
+// NewLimiter returns a rate limiter that allows perSecond requests per second, with bursts
+// of up to burst requests, and no more than maxInFlight requests running concurrently.
+// Set perSecond to 0 to disable the rate limit, and maxInFlight to 0 to disable the concurrency cap.
+// A request holds its in-flight slot until its response body is closed.
+func NewLimiter(perSecond float64, burst, maxInFlight int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	limiter := &Limiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+
+	if maxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, maxInFlight)
+	}
+
+	return limiter
+}
+
+// Wait blocks until a request is allowed to proceed, or the context is canceled.
+// The returned function must be called when the request finishes to release its in-flight slot.
+// A nil Limiter never blocks.
+func (l *Limiter) Wait(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if err := l.take(ctx); err != nil {
+		return nil, err
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-l.inFlight }) }, nil
+	case <-ctx.Done():
+		l.give() // the request never ran, so it must not count against the rate.
+		return nil, fmt.Errorf("waiting for in-flight request slot: %w", ctx.Err())
+	}
+}
+
+// take removes a token from the bucket, waiting for one to become available if needed.
+func (l *Limiter) take(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens-- // reserve a token, possibly going negative.
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.give()
+		return fmt.Errorf("waiting for rate limiter: %w", ctx.Err())
+	}
+}
+
+// give returns a token taken by take to the bucket.
+func (l *Limiter) give() {
+	if l.rate <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// releaseCloser releases a limiter slot when the response body is closed.
+type releaseCloser struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the response body and releases the in-flight slot.
+func (r *releaseCloser) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close() //nolint:wrapcheck
+}
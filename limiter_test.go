@@ -0,0 +1,94 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterInFlight(t *testing.T) {
+	t.Parallel()
+
+	var current, highest int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		now := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for old := atomic.LoadInt32(&highest); now > old; old = atomic.LoadInt32(&highest) {
+			if atomic.CompareAndSwapInt32(&highest, old, now) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		_, _ = writer.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+	config.Limiter = starr.NewLimiter(0, 0, 2)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var output interface{}
+			assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/thing"}, &output))
+		}()
+	}
+
+	wg.Wait()
+	assert.EqualValues(t, 2, atomic.LoadInt32(&highest), "no more than 2 requests may run at once")
+}
+
+func TestLimiterRateCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := starr.NewLimiter(0.01, 1, 0)
+
+	release, err := limiter.Wait(context.Background())
+	require.NoError(t, err, "the first request must use the burst token")
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "a canceled context must stop the wait")
+}
+
+func TestLimiterInFlightCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := starr.NewLimiter(0.01, 2, 1)
+
+	release, err := limiter.Wait(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "a canceled context must stop the in-flight wait")
+	release()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release, err = limiter.Wait(ctx)
+	require.NoError(t, err, "the canceled request must give its rate token back")
+	release()
+}
@@ -0,0 +1,331 @@
+package starr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+/* This file contains the config loader. It reads instances from files and the environment. */
+
+// Errors returned when loading configs.
+var (
+	ErrInvalidConfig = errors.New("invalid config")
+	ErrNoUnmarshaler = errors.New("no unmarshaler for config file extension")
+)
+
+// Unmarshaler decodes a config file, like json.Unmarshal or toml.Unmarshal.
+type Unmarshaler func(data []byte, output interface{}) error
+
+// LoadedConfig is one instance read by a ConfigLoader. After loading, Config has an http Client
+// built with Timeout and VerifySSL, and is ready to pass into an app package's New procedure.
+type LoadedConfig struct {
+	Name      string   `json:"name"      toml:"name"       xml:"name"       yaml:"name"`
+	App       App      `json:"app"       toml:"app"        xml:"app"        yaml:"app"`
+	Timeout   Duration `json:"timeout"   toml:"timeout"    xml:"timeout"    yaml:"timeout"`
+	VerifySSL bool     `json:"verifySsl" toml:"verify_ssl" xml:"verify_ssl" yaml:"verifySsl"`
+	*Config   `yaml:",inline"`
+}
+
+// Duration is a time.Duration that decodes from strings like "30s", or from a number of seconds.
+type Duration struct {
+	time.Duration
+}
+
+// ConfigLoader reads instance configs from files and environment variables.
+type ConfigLoader struct {
+	// Prefix for environment variables, like SONARR. Empty skips the environment.
+	// Variables are PREFIX_URL, PREFIX_API_KEY, PREFIX_HTTP_USER, PREFIX_HTTP_PASS, PREFIX_USERNAME,
+	// PREFIX_PASSWORD, PREFIX_TIMEOUT, PREFIX_VERIFY_SSL, PREFIX_NAME and PREFIX_APP.
+	// More instances use a number after the prefix, like PREFIX_2_URL.
+	// Any variable may instead end with _FILE and contain the path to a file with the value, like a docker secret.
+	Prefix string
+	// Files to read. Each file has one config, or a list of them.
+	Files []string
+	// Unmarshalers decode files by extension, like ".toml". JSON and YAML are built in, and may be replaced.
+	Unmarshalers map[string]Unmarshaler
+	// Timeout is used for configs without one. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// LoadConfig reads instance configs from files, then from environment variables that start with prefix.
+// JSON and YAML files are supported; use a ConfigLoader to read other formats.
+func LoadConfig(prefix string, files ...string) ([]*LoadedConfig, error) {
+	return (&ConfigLoader{Prefix: prefix, Files: files}).Load()
+}
+
+// Load reads every file, then the environment, and returns the validated configs.
+func (l *ConfigLoader) Load() ([]*LoadedConfig, error) {
+	var configs []*LoadedConfig
+
+	for _, file := range l.Files {
+		loaded, err := l.loadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, loaded...)
+	}
+
+	loaded, err := l.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	configs = append(configs, loaded...)
+
+	for _, config := range configs {
+		if err := config.setup(l.Timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	return configs, nil
+}
+
+func (l *ConfigLoader) loadFile(file string) ([]*LoadedConfig, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	ext := strings.ToLower(filepath.Ext(file))
+
+	unmarshal := l.Unmarshalers[ext]
+	if unmarshal == nil {
+		switch ext {
+		case ".json":
+			unmarshal = json.Unmarshal
+		case ".yaml", ".yml":
+			unmarshal = yaml.Unmarshal
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrNoUnmarshaler, file)
+		}
+	}
+
+	var configs []*LoadedConfig
+
+	// Try a list first, then a single config.
+	if err := unmarshal(data, &configs); err == nil {
+		return configs, nil
+	}
+
+	var config LoadedConfig
+	if err := unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("decoding config %s: %w", file, err)
+	}
+
+	return []*LoadedConfig{&config}, nil
+}
+
+// loadEnv reads PREFIX_* and PREFIX_<n>_* variables, ordered by number.
+func (l *ConfigLoader) loadEnv() ([]*LoadedConfig, error) {
+	if l.Prefix == "" {
+		return nil, nil
+	}
+
+	prefix := strings.ToUpper(strings.TrimSuffix(l.Prefix, "_"))
+	find := regexp.MustCompile(`^` + regexp.QuoteMeta(prefix) + `(_\d+)?_URL(_FILE)?=`)
+	found := map[string]bool{}
+
+	for _, env := range os.Environ() {
+		if match := find.FindStringSubmatch(env); match != nil {
+			found[prefix+match[1]] = true
+		}
+	}
+
+	prefixes := make([]string, 0, len(found))
+	for key := range found {
+		prefixes = append(prefixes, key)
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool { return envNumber(prefixes[i]) < envNumber(prefixes[j]) })
+
+	configs := make([]*LoadedConfig, 0, len(prefixes))
+
+	for _, envPrefix := range prefixes {
+		config, err := loadEnvConfig(envPrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Name == "" {
+			config.Name = strings.ToLower(envPrefix)
+		}
+
+		if config.App == "" {
+			config.App = findApp(prefix)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+func loadEnvConfig(prefix string) (*LoadedConfig, error) {
+	values := map[string]string{}
+
+	for _, name := range []string{
+		"NAME", "APP", "URL", "API_KEY", "HTTP_USER", "HTTP_PASS", "USERNAME", "PASSWORD", "TIMEOUT", "VERIFY_SSL",
+	} {
+		value, err := getEnv(prefix + "_" + name)
+		if err != nil {
+			return nil, err
+		}
+
+		values[name] = value
+	}
+
+	config := &LoadedConfig{
+		Name: values["NAME"],
+		App:  App(values["APP"]),
+		Config: &Config{
+			URL:      values["URL"],
+			APIKey:   values["API_KEY"],
+			HTTPUser: values["HTTP_USER"],
+			HTTPPass: values["HTTP_PASS"],
+			Username: values["USERNAME"],
+			Password: values["PASSWORD"],
+		},
+	}
+
+	if values["TIMEOUT"] != "" {
+		if err := config.Timeout.UnmarshalText([]byte(values["TIMEOUT"])); err != nil {
+			return nil, fmt.Errorf("%w: %s_TIMEOUT: %w", ErrInvalidConfig, prefix, err)
+		}
+	}
+
+	if values["VERIFY_SSL"] != "" {
+		var err error
+		if config.VerifySSL, err = strconv.ParseBool(values["VERIFY_SSL"]); err != nil {
+			return nil, fmt.Errorf("%w: %s_VERIFY_SSL: %w", ErrInvalidConfig, prefix, err)
+		}
+	}
+
+	return config, nil
+}
+
+// getEnv returns a variable, or the trimmed contents of the file named in the variable with _FILE on the end.
+func getEnv(name string) (string, error) {
+	if value, ok := os.LookupEnv(name); ok {
+		return value, nil
+	}
+
+	file := os.Getenv(name + "_FILE")
+	if file == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("reading %s_FILE: %w", name, err)
+	}
+
+	return string(bytes.TrimSpace(data)), nil
+}
+
+// envNumber returns the instance number in a prefix like SONARR_2. The plain prefix is first.
+func envNumber(prefix string) int {
+	idx := strings.LastIndex(prefix, "_")
+	if idx == -1 {
+		return -1
+	}
+
+	num, err := strconv.Atoi(prefix[idx+1:])
+	if err != nil {
+		return -1
+	}
+
+	return num
+}
+
+// findApp returns the app with the same name as the prefix, if there is one.
+func findApp(prefix string) App {
+	for _, app := range []App{Lidarr, Prowlarr, Radarr, Readarr, Sonarr, Whisparr} {
+		if strings.EqualFold(string(app), prefix) {
+			return app
+		}
+	}
+
+	return ""
+}
+
+// setup validates the config and creates its http client.
+func (c *LoadedConfig) setup(timeout time.Duration) error {
+	if c.Config == nil {
+		return fmt.Errorf("%w: %s: missing url", ErrInvalidConfig, c.Name)
+	}
+
+	parsed, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidConfig, c.Name, err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: %s: url must start with http:// or https://: %s", ErrInvalidConfig, c.Name, c.URL)
+	}
+
+	if c.APIKey == "" {
+		return fmt.Errorf("%w: %s: missing api key", ErrInvalidConfig, c.Name)
+	}
+
+	if c.Timeout.Duration == 0 {
+		c.Timeout.Duration = timeout
+	}
+
+	if c.Timeout.Duration == 0 {
+		c.Timeout.Duration = DefaultTimeout
+	}
+
+	if c.Client == nil {
+		c.Client = Client(c.Timeout.Duration, c.VerifySSL)
+	}
+
+	return nil
+}
+
+// UnmarshalText parses a duration string like "30s", or a number of seconds.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if seconds, err := strconv.ParseFloat(string(text), 64); err == nil {
+		d.Duration = time.Duration(seconds * float64(time.Second))
+		return nil
+	}
+
+	var err error
+	if d.Duration, err = time.ParseDuration(string(text)); err != nil {
+		return fmt.Errorf("parsing duration: %w", err)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON parses a duration string, or a number of seconds.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if _, err := strconv.ParseFloat(string(data), 64); err == nil {
+		return d.UnmarshalText(data)
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("parsing duration: %w", err)
+	}
+
+	return d.UnmarshalText([]byte(text))
+}
+
+// MarshalText writes a duration string like "30s".
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.Duration.String()), nil
+}
@@ -0,0 +1,93 @@
+package starr_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv.
+	dir := t.TempDir()
+	file := filepath.Join(dir, "starr.json")
+	secret := filepath.Join(dir, "api_key")
+
+	require.NoError(t, os.WriteFile(file, []byte(`[
+		{"name":"4k","app":"Radarr","url":"http://radarr:7878","apiKey":"one","timeout":"1m","verifySsl":true},
+		{"name":"anime","app":"Sonarr","url":"https://sonarr","apiKey":"two","timeout":5}
+	]`), 0o600))
+	require.NoError(t, os.WriteFile(secret, []byte("three\n"), 0o600))
+
+	t.Setenv("STARRTEST_URL", "http://localhost:8989")
+	t.Setenv("STARRTEST_API_KEY_FILE", secret)
+	t.Setenv("STARRTEST_2_URL", "http://localhost:8990")
+	t.Setenv("STARRTEST_2_API_KEY", "four")
+	t.Setenv("STARRTEST_2_TIMEOUT", "10s")
+	t.Setenv("STARRTEST_2_NAME", "kids")
+
+	configs, err := starr.LoadConfig("STARRTEST", file)
+	require.NoError(t, err)
+	require.Len(t, configs, 4)
+
+	assert.Equal(t, "4k", configs[0].Name)
+	assert.Equal(t, starr.Radarr, configs[0].App)
+	assert.Equal(t, time.Minute, configs[0].Client.Timeout)
+	assert.Equal(t, 5*time.Second, configs[1].Timeout.Duration, "numbers must be seconds")
+	assert.Equal(t, "starrtest", configs[2].Name)
+	assert.Equal(t, "three", configs[2].APIKey, "secrets must be read from _FILE variables")
+	assert.Equal(t, starr.DefaultTimeout, configs[2].Client.Timeout)
+	assert.Equal(t, "kids", configs[3].Name)
+	assert.Equal(t, 10*time.Second, configs[3].Client.Timeout)
+
+	t.Setenv("STARRTEST_2_URL", "localhost:8990")
+
+	_, err = starr.LoadConfig("STARRTEST")
+	require.ErrorIs(t, err, starr.ErrInvalidConfig, "urls must have a scheme")
+
+	_, err = starr.LoadConfig("", filepath.Join(dir, "missing.yaml"))
+	require.Error(t, err)
+
+	yaml := filepath.Join(dir, "starr.yml")
+	require.NoError(t, os.WriteFile(yaml, []byte(`
+- name: music
+  app: Lidarr
+  url: http://lidarr:8686
+  apiKey: five
+  timeout: 30
+  verifySsl: true
+- name: books
+  url: http://readarr:8787
+  apiKey: six
+  timeout: 2m
+`), 0o600))
+
+	configs, err = starr.LoadConfig("", yaml)
+	require.NoError(t, err, "yaml must be supported without an unmarshaler")
+	require.Len(t, configs, 2)
+	assert.Equal(t, starr.Lidarr, configs[0].App)
+	assert.Equal(t, "five", configs[0].APIKey, "the embedded config must be inlined")
+	assert.Equal(t, 30*time.Second, configs[0].Timeout.Duration, "numbers must be seconds")
+	assert.True(t, configs[0].VerifySSL)
+	assert.Equal(t, 2*time.Minute, configs[1].Client.Timeout)
+
+	toml := filepath.Join(dir, "starr.toml")
+	require.NoError(t, os.WriteFile(toml, []byte(`{"url":"http://lidarr","apiKey":"seven"}`), 0o600))
+
+	_, err = starr.LoadConfig("", toml)
+	require.ErrorIs(t, err, starr.ErrNoUnmarshaler)
+
+	loader := &starr.ConfigLoader{
+		Files:        []string{toml},
+		Unmarshalers: map[string]starr.Unmarshaler{".toml": json.Unmarshal}, // stand-in for toml.Unmarshal.
+	}
+
+	configs, err = loader.Load()
+	require.NoError(t, err)
+	require.Len(t, configs, 1, "a file may have a single config")
+	assert.Equal(t, "seven", configs[0].APIKey)
+}
@@ -0,0 +1,227 @@
+// Package manager keeps many named Starr app instances, and runs calls on all of them at once.
+// Use it when you have more than one instance of an app, like a 4K Radarr and an anime Sonarr.
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/prowlarr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/sonarr"
+)
+
+// Errors returned by this package.
+var (
+	ErrNoName       = errors.New("instance has no name")
+	ErrNoConfig     = errors.New("instance has no config")
+	ErrDuplicate    = errors.New("instance name already exists")
+	ErrUnknownApp   = errors.New("unknown app")
+	ErrNotSupported = errors.New("not supported by this app")
+)
+
+// InstanceConfig is the input to load an instance. It is the same type starr.LoadConfig returns,
+// so loaded configs may be passed straight to Load. It also decodes from a config file
+// with the name and app next to the starr.Config fields.
+type InstanceConfig = starr.LoadedConfig
+
+// Instance is one named app instance, with a client for its app.
+type Instance struct {
+	Name   string
+	App    starr.App
+	Config *starr.Config
+	// Client is one of *lidarr.Lidarr, *prowlarr.Prowlarr, *radarr.Radarr, *readarr.Readarr or *sonarr.Sonarr.
+	Client interface{}
+}
+
+// Manager holds app instances by name. It is safe for concurrent use.
+type Manager struct {
+	mu        sync.RWMutex
+	instances map[string]*Instance
+}
+
+// New returns an empty manager.
+func New() *Manager {
+	return &Manager{instances: make(map[string]*Instance)}
+}
+
+// Load adds many instances. Nothing is added if any of them is invalid.
+func (m *Manager) Load(configs ...*InstanceConfig) error {
+	instances := make([]*Instance, len(configs))
+	names := make(map[string]bool, len(configs))
+
+	for idx, config := range configs {
+		if config == nil {
+			return fmt.Errorf("%w: config %d is nil", ErrNoConfig, idx)
+		}
+
+		if config.Config != nil && config.Client == nil {
+			config.Client = starr.Client(timeout(config.Timeout.Duration), config.VerifySSL)
+		}
+
+		instance, err := newInstance(config.Name, config.App, config.Config)
+		if err != nil {
+			return err
+		}
+
+		if names[strings.ToLower(config.Name)] {
+			return fmt.Errorf("%w: %s", ErrDuplicate, config.Name)
+		}
+
+		names[strings.ToLower(config.Name)], instances[idx] = true, instance
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, instance := range instances {
+		if m.instances[strings.ToLower(instance.Name)] != nil {
+			return fmt.Errorf("%w: %s", ErrDuplicate, instance.Name)
+		}
+	}
+
+	for _, instance := range instances {
+		m.instances[strings.ToLower(instance.Name)] = instance
+	}
+
+	return nil
+}
+
+// Add creates a client for app with config, and adds it by name. Names are not case sensitive.
+func (m *Manager) Add(name string, app starr.App, config *starr.Config) (*Instance, error) {
+	instance, err := newInstance(name, app, config)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.instances[strings.ToLower(name)] != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDuplicate, name)
+	}
+
+	m.instances[strings.ToLower(name)] = instance
+
+	return instance, nil
+}
+
+// Remove removes an instance by name.
+func (m *Manager) Remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.instances, strings.ToLower(name))
+}
+
+// Get returns an instance by name, or nil if it does not exist.
+func (m *Manager) Get(name string) *Instance {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.instances[strings.ToLower(name)]
+}
+
+// Instances returns the instances of the provided apps, or every instance if none are provided.
+// They are sorted by name.
+func (m *Manager) Instances(apps ...starr.App) []*Instance {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	output := make([]*Instance, 0, len(m.instances))
+
+	for _, instance := range m.instances {
+		if len(apps) == 0 || hasApp(apps, instance.App) {
+			output = append(output, instance)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool { return strings.ToLower(output[i].Name) < strings.ToLower(output[j].Name) })
+
+	return output
+}
+
+// timeout returns the default timeout for configs that were not loaded with one.
+func timeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return starr.DefaultTimeout
+	}
+
+	return timeout
+}
+
+func hasApp(apps []starr.App, app starr.App) bool {
+	for _, wanted := range apps {
+		if strings.EqualFold(string(wanted), string(app)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newInstance(name string, app starr.App, config *starr.Config) (*Instance, error) {
+	if name == "" {
+		return nil, ErrNoName
+	}
+
+	if config == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoConfig, name)
+	}
+
+	instance := &Instance{Name: name, Config: config}
+
+	switch {
+	case strings.EqualFold(string(app), string(starr.Lidarr)):
+		instance.App, instance.Client = starr.Lidarr, lidarr.New(config)
+	case strings.EqualFold(string(app), string(starr.Prowlarr)):
+		instance.App, instance.Client = starr.Prowlarr, prowlarr.New(config)
+	case strings.EqualFold(string(app), string(starr.Radarr)):
+		instance.App, instance.Client = starr.Radarr, radarr.New(config)
+	case strings.EqualFold(string(app), string(starr.Readarr)):
+		instance.App, instance.Client = starr.Readarr, readarr.New(config)
+	case strings.EqualFold(string(app), string(starr.Sonarr)):
+		instance.App, instance.Client = starr.Sonarr, sonarr.New(config)
+	default:
+		return nil, fmt.Errorf("%w: %s (%s)", ErrUnknownApp, app, name)
+	}
+
+	return instance, nil
+}
+
+// Lidarr returns the instance's client, or nil if it is not a Lidarr instance.
+func (i *Instance) Lidarr() *lidarr.Lidarr {
+	client, _ := i.Client.(*lidarr.Lidarr)
+	return client
+}
+
+// Prowlarr returns the instance's client, or nil if it is not a Prowlarr instance.
+func (i *Instance) Prowlarr() *prowlarr.Prowlarr {
+	client, _ := i.Client.(*prowlarr.Prowlarr)
+	return client
+}
+
+// Radarr returns the instance's client, or nil if it is not a Radarr instance.
+func (i *Instance) Radarr() *radarr.Radarr {
+	client, _ := i.Client.(*radarr.Radarr)
+	return client
+}
+
+// Readarr returns the instance's client, or nil if it is not a Readarr instance.
+func (i *Instance) Readarr() *readarr.Readarr {
+	client, _ := i.Client.(*readarr.Readarr)
+	return client
+}
+
+// Sonarr returns the instance's client, or nil if it is not a Sonarr instance.
+func (i *Instance) Sonarr() *sonarr.Sonarr {
+	client, _ := i.Client.(*sonarr.Sonarr)
+	return client
+}
@@ -0,0 +1,80 @@
+package manager_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/manager"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	anime := starrtest.NewFakeSonarr("anime-key")
+	defer anime.Close()
+
+	movies := starrtest.NewFakeRadarr("4k-key")
+	defer movies.Close()
+
+	_, err := movies.Add("queue", &radarr.QueueRecord{MovieID: 1})
+	require.NoError(t, err)
+
+	var configs []*manager.InstanceConfig
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name":"anime","app":"sonarr","apiKey":"anime-key","url":"`+anime.URL+`"},
+		{"name":"4K","app":"Radarr","apiKey":"4k-key","url":"`+movies.URL+`"},
+		{"name":"broken","app":"Radarr","apiKey":"wrong","url":"`+movies.URL+`"}
+	]`), &configs))
+
+	mgr := manager.New()
+	require.NoError(t, mgr.Load(configs...))
+	require.ErrorIs(t, mgr.Load(configs[0]), manager.ErrDuplicate)
+	require.ErrorIs(t, mgr.Load(nil), manager.ErrNoConfig)
+
+	_, err = mgr.Add("lists", starr.App("Listarr"), starr.New("key", anime.URL, 0))
+	require.ErrorIs(t, err, manager.ErrUnknownApp)
+
+	assert.IsType(t, &sonarr.Sonarr{}, mgr.Get("ANIME").Sonarr(), "names must not be case sensitive")
+	assert.Nil(t, mgr.Get("anime").Radarr())
+	assert.Len(t, mgr.Instances(starr.Radarr), 2)
+
+	pings := mgr.PingAll(context.Background())
+	require.Len(t, pings, 3)
+	assert.Nil(t, manager.Errors(pings), "ping does not need an api key")
+
+	statuses := mgr.SystemStatusAll(context.Background())
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "4K", statuses[0].Instance.Name, "results must be sorted by name")
+	assert.Equal(t, "Radarr", statuses[0].Output.(*radarr.SystemStatus).AppName)
+	assert.Equal(t, "Sonarr", statuses[1].Output.(*sonarr.SystemStatus).AppName)
+	require.ErrorIs(t, manager.Errors(statuses)["broken"], &starr.ReqError{Code: 401})
+	assert.True(t, statuses[2].Output == nil, "errors must not return a typed nil pointer")
+
+	queues := mgr.QueueAll(context.Background(), 0, 0, starr.Radarr)
+	require.Len(t, queues, 2)
+	require.NoError(t, queues[0].Err)
+	assert.Len(t, queues[0].Output.(*radarr.Queue).Records, 1)
+}
+
+func TestLoadConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv.
+	anime := starrtest.NewFakeSonarr("anime-key")
+	defer anime.Close()
+
+	t.Setenv("MANAGERTEST_URL", anime.URL)
+	t.Setenv("MANAGERTEST_API_KEY", "anime-key")
+	t.Setenv("MANAGERTEST_APP", "sonarr")
+
+	configs, err := starr.LoadConfig("MANAGERTEST")
+	require.NoError(t, err)
+
+	mgr := manager.New()
+	require.NoError(t, mgr.Load(configs...), "loaded configs must be accepted")
+	require.NotNil(t, mgr.Get("managertest").Sonarr())
+}
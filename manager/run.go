@@ -0,0 +1,165 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/prowlarr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/sonarr"
+)
+
+// Result is the output from one instance when a call runs on many of them.
+type Result[T any] struct {
+	Instance *Instance
+	Output   T
+	Err      error
+}
+
+// Run calls method for every instance at the same time, and returns the results in the same order as instances.
+func Run[T any](
+	ctx context.Context,
+	instances []*Instance,
+	method func(context.Context, *Instance) (T, error),
+) []*Result[T] {
+	results := make([]*Result[T], len(instances))
+
+	var wg sync.WaitGroup
+
+	for idx, instance := range instances {
+		wg.Add(1)
+
+		go func(idx int, instance *Instance) {
+			defer wg.Done()
+
+			output, err := method(ctx, instance)
+			if err != nil {
+				err = fmt.Errorf("%s (%s): %w", instance.Name, instance.App, err)
+			}
+
+			results[idx] = &Result[T]{Instance: instance, Output: output, Err: err}
+		}(idx, instance)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// Errors returns the errors from results, by instance name. It returns nil if there are none.
+func Errors[T any](results []*Result[T]) map[string]error {
+	var errs map[string]error
+
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+
+		if errs == nil {
+			errs = make(map[string]error)
+		}
+
+		errs[result.Instance.Name] = result.Err
+	}
+
+	return errs
+}
+
+// PingAll pings the provided apps' instances, or every instance if no apps are provided.
+// The output is how long each ping took.
+func (m *Manager) PingAll(ctx context.Context, apps ...starr.App) []*Result[time.Duration] {
+	return Run(ctx, m.Instances(apps...), func(ctx context.Context, instance *Instance) (time.Duration, error) {
+		return instance.Ping(ctx)
+	})
+}
+
+// SystemStatusAll gets the system status from the provided apps' instances, or every instance if no apps are provided.
+// The output is a *SystemStatus from the instance's app package.
+func (m *Manager) SystemStatusAll(ctx context.Context, apps ...starr.App) []*Result[interface{}] {
+	return Run(ctx, m.Instances(apps...), func(ctx context.Context, instance *Instance) (interface{}, error) {
+		return instance.SystemStatus(ctx)
+	})
+}
+
+// QueueAll gets the queue from the provided apps' instances, or every instance if no apps are provided.
+// The output is a *Queue from the instance's app package. Prowlarr has no queue, and returns ErrNotSupported.
+// records and perPage are passed to each app's GetQueue method.
+func (m *Manager) QueueAll(ctx context.Context, records, perPage int, apps ...starr.App) []*Result[interface{}] {
+	return Run(ctx, m.Instances(apps...), func(ctx context.Context, instance *Instance) (interface{}, error) {
+		return instance.Queue(ctx, records, perPage)
+	})
+}
+
+// output returns a nil interface, instead of a typed nil pointer, when there is an error.
+func output[T any](value *T, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Ping pings the instance, and returns how long it took.
+func (i *Instance) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+
+	var err error
+
+	switch client := i.Client.(type) {
+	case *lidarr.Lidarr:
+		err = client.PingContext(ctx)
+	case *prowlarr.Prowlarr:
+		err = client.PingContext(ctx)
+	case *radarr.Radarr:
+		err = client.PingContext(ctx)
+	case *readarr.Readarr:
+		err = client.PingContext(ctx)
+	case *sonarr.Sonarr:
+		err = client.PingContext(ctx)
+	default:
+		err = ErrUnknownApp
+	}
+
+	return time.Since(start), err
+}
+
+// SystemStatus returns the *SystemStatus from the instance's app package.
+func (i *Instance) SystemStatus(ctx context.Context) (interface{}, error) {
+	switch client := i.Client.(type) {
+	case *lidarr.Lidarr:
+		return output(client.GetSystemStatusContext(ctx))
+	case *prowlarr.Prowlarr:
+		return output(client.GetSystemStatusContext(ctx))
+	case *radarr.Radarr:
+		return output(client.GetSystemStatusContext(ctx))
+	case *readarr.Readarr:
+		return output(client.GetSystemStatusContext(ctx))
+	case *sonarr.Sonarr:
+		return output(client.GetSystemStatusContext(ctx))
+	default:
+		return nil, ErrUnknownApp
+	}
+}
+
+// Queue returns the *Queue from the instance's app package. Prowlarr has no queue.
+func (i *Instance) Queue(ctx context.Context, records, perPage int) (interface{}, error) {
+	switch client := i.Client.(type) {
+	case *lidarr.Lidarr:
+		return output(client.GetQueueContext(ctx, records, perPage))
+	case *radarr.Radarr:
+		return output(client.GetQueueContext(ctx, records, perPage))
+	case *readarr.Readarr:
+		return output(client.GetQueueContext(ctx, records, perPage))
+	case *sonarr.Sonarr:
+		return output(client.GetQueueContext(ctx, records, perPage))
+	case *prowlarr.Prowlarr:
+		return nil, ErrNotSupported
+	default:
+		return nil, ErrUnknownApp
+	}
+}
@@ -1,6 +1,7 @@
 package starr
 
 import (
+	"context"
 	"net/url"
 	"strconv"
 	"strings"
@@ -185,3 +186,86 @@ func AdjustPerPage(records, total, collected, perPage int) int {
 
 	return perPage
 }
+
+// PageFunc retrieves a single page of records from a page-able endpoint.
+// It returns the records on the page and the total record count reported by the app.
+// The app packages provide these, wrapping methods like GetHistoryPageContext().
+type PageFunc[T any] func(ctx context.Context, params *PageReq) (records []T, total int, err error)
+
+// Pager walks a page-able endpoint one page at a time, so large lists (like History)
+// may be processed without holding every record in memory. Create one with NewPager,
+// or with a *Pager() method in an app package. A Pager is not safe for concurrent use.
+// To stop early, simply stop calling Next.
+//
+//	pager := sonarr.New(config).HistoryPager(&starr.PageReq{SortDir: starr.SortDescend})
+//	for pager.More() {
+//		records, err := pager.Next(ctx)
+//		...
+//	}
+type Pager[T any] struct {
+	fetch  PageFunc[T]
+	params PageReq
+	total  int
+	done   bool
+}
+
+// NewPager returns a Pager that calls fetch for each page. The sort, filter and extra values
+// in params are sent with every request. Paging starts at params.Page, or the first page if
+// not set. params.PageSize defaults to 500 when not set. params is copied, and may be nil.
+func NewPager[T any](params *PageReq, fetch PageFunc[T]) *Pager[T] {
+	pager := &Pager[T]{fetch: fetch}
+
+	if params != nil {
+		pager.params = *params
+		pager.params.Values = make(url.Values, len(params.Values))
+
+		for k, v := range params.Values {
+			pager.params.Values[k] = append([]string(nil), v...)
+		}
+	}
+
+	if pager.params.Page < 1 {
+		pager.params.Page = 1
+	}
+
+	pager.params.PageSize = SetPerPage(0, pager.params.PageSize)
+
+	return pager
+}
+
+// More returns true if there may be more records to retrieve with Next.
+func (p *Pager[T]) More() bool {
+	return !p.done
+}
+
+// Total returns the total record count reported by the app. This is zero until Next is called.
+func (p *Pager[T]) Total() int {
+	return p.total
+}
+
+// Page returns the page number that the next call to Next retrieves.
+func (p *Pager[T]) Page() int {
+	return p.params.Page
+}
+
+// Next retrieves the next page of records. It returns no records and no error when
+// there are no more pages. An error does not advance the page, so Next may be retried.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	// The fetch function may change the params, so give it a copy.
+	params := p.params
+
+	records, total, err := p.fetch(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	p.total = total
+	p.done = len(records) == 0 || len(records) < p.params.PageSize || p.params.Page*p.params.PageSize >= total
+	p.params.Page++
+
+	return records, nil
+}
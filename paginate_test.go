@@ -0,0 +1,64 @@
+package starr_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPager(t *testing.T) {
+	t.Parallel()
+
+	const total = 25
+
+	var pages []int
+
+	params := &starr.PageReq{PageSize: 10, SortDir: starr.SortDescend, Values: url.Values{"eventType": {"1"}}}
+	pager := starr.NewPager(params, func(_ context.Context, params *starr.PageReq) ([]int, int, error) {
+		assert.Equal(t, "descending", params.Params().Get("sortDirection"), "sort settings must be sent")
+		assert.Equal(t, "1", params.Params().Get("eventType"), "extra values must be sent")
+
+		pages = append(pages, params.Page)
+		output := []int{}
+
+		for i := (params.Page - 1) * params.PageSize; i < total && i < params.Page*params.PageSize; i++ {
+			output = append(output, i)
+		}
+
+		return output, total, nil
+	})
+
+	var records []int
+
+	for pager.More() {
+		page, err := pager.Next(context.Background())
+		require.NoError(t, err)
+
+		records = append(records, page...)
+	}
+
+	assert.Len(t, records, total, "every record must be returned")
+	assert.Equal(t, []int{1, 2, 3}, pages, "exactly 3 pages must be requested")
+	assert.Equal(t, total, pager.Total())
+	assert.Zero(t, params.Page, "the input params must not be modified")
+}
+
+func TestPagerError(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	pager := starr.NewPager(nil, func(_ context.Context, params *starr.PageReq) ([]string, int, error) {
+		assert.Equal(t, 500, params.PageSize, "default page size must be applied")
+		return nil, 0, errTest
+	})
+
+	_, err := pager.Next(context.Background())
+	require.ErrorIs(t, err, errTest)
+	assert.True(t, pager.More(), "an error must not end the pager")
+	assert.Equal(t, 1, pager.Page(), "an error must not advance the page")
+}
@@ -0,0 +1,155 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpCommand = APIver + "/command"
+
+// CommandRequest goes into the /api/v1/command endpoint.
+// Prowlarr commands, like Backup, do not take any parameters.
+type CommandRequest struct {
+	Name string `json:"name"`
+}
+
+// CommandResponse comes from the /api/v1/command endpoint.
+type CommandResponse struct {
+	ID                  int64                  `json:"id"`
+	Name                string                 `json:"name"`
+	CommandName         string                 `json:"commandName"`
+	Message             string                 `json:"message,omitempty"`
+	Priority            string                 `json:"priority"`
+	Status              string                 `json:"status"`
+	Queued              time.Time              `json:"queued"`
+	Started             time.Time              `json:"started,omitempty"`
+	Ended               time.Time              `json:"ended,omitempty"`
+	StateChangeTime     time.Time              `json:"stateChangeTime,omitempty"`
+	LastExecutionTime   time.Time              `json:"lastExecutionTime,omitempty"`
+	Duration            string                 `json:"duration,omitempty"`
+	Trigger             string                 `json:"trigger"`
+	SendUpdatesToClient bool                   `json:"sendUpdatesToClient"`
+	UpdateScheduledTask bool                   `json:"updateScheduledTask"`
+	Body                map[string]interface{} `json:"body"`
+}
+
+// GetCommands returns all available Prowlarr commands.
+func (p *Prowlarr) GetCommands() ([]*CommandResponse, error) {
+	return p.GetCommandsContext(context.Background())
+}
+
+// GetCommandsContext returns all available Prowlarr commands.
+func (p *Prowlarr) GetCommandsContext(ctx context.Context) ([]*CommandResponse, error) {
+	var output []*CommandResponse
+
+	req := starr.Request{URI: bpCommand}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// SendCommand sends a command to Prowlarr.
+func (p *Prowlarr) SendCommand(cmd *CommandRequest) (*CommandResponse, error) {
+	return p.SendCommandContext(context.Background(), cmd)
+}
+
+// SendCommandContext sends a command to Prowlarr.
+func (p *Prowlarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if cmd == nil || cmd.Name == "" {
+		return &output, nil
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(cmd); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCommand, err)
+	}
+
+	req := starr.Request{URI: bpCommand, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetCommandStatus returns the status of an already started command.
+func (p *Prowlarr) GetCommandStatus(commandID int64) (*CommandResponse, error) {
+	return p.GetCommandStatusContext(context.Background(), commandID)
+}
+
+// GetCommandStatusContext returns the status of an already started command.
+func (p *Prowlarr) GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if commandID == 0 {
+		return &output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpCommand, starr.Str(commandID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// WaitForCommand polls a command's status until it is completed, failed or aborted.
+// The final command status is returned. A command that does not complete
+// returns a *starr.CommandError, which matches starr.ErrCommandFailed.
+// pollInterval defaults to starr.DefaultCommandPollInterval when zero.
+func (p *Prowlarr) WaitForCommand(commandID int64, pollInterval time.Duration) (*CommandResponse, error) {
+	return p.WaitForCommandContext(context.Background(), commandID, pollInterval)
+}
+
+// WaitForCommandContext polls a command's status until it is completed, failed or aborted.
+// Use a context with a deadline to limit how long this waits. See WaitForCommand for more.
+func (p *Prowlarr) WaitForCommandContext(
+	ctx context.Context,
+	commandID int64,
+	pollInterval time.Duration,
+) (*CommandResponse, error) {
+	if commandID < 1 {
+		return nil, fmt.Errorf("%w: invalid command ID: %d", starr.ErrRequestError, commandID)
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = starr.DefaultCommandPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := p.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Status == starr.CommandCompleted {
+			return status, nil
+		} else if starr.CommandDone(status.Status) {
+			return status, &starr.CommandError{
+				ID:      status.ID,
+				Name:    status.Name,
+				Status:  status.Status,
+				Message: status.Message,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for command %d: %w", commandID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
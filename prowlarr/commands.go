@@ -0,0 +1,25 @@
+package prowlarr
+
+import "github.com/BSFishy/starr"
+
+/* This file contains constructors for the commands Prowlarr supports. Use them with SendCommand. */
+
+// ApplicationIndexerSyncCommand syncs the indexers to every connected application.
+func ApplicationIndexerSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ApplicationIndexerSync"}
+}
+
+// BackupCommand creates a manual backup. See CreateBackup for a method that waits for it.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: starr.BackupCommand}
+}
+
+// CheckHealthCommand runs all health checks.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// HousekeepingCommand runs the database housekeeping tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
@@ -0,0 +1,28 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpHealth = APIver + "/health"
+
+// GetHealth returns all active health check problems from the /api/v1/health endpoint.
+// An empty list means Prowlarr found no problems with itself.
+func (p *Prowlarr) GetHealth() ([]*starr.HealthCheck, error) {
+	return p.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns all active health check problems from the /api/v1/health endpoint.
+func (p *Prowlarr) GetHealthContext(ctx context.Context) ([]*starr.HealthCheck, error) {
+	var output []*starr.HealthCheck
+
+	req := starr.Request{URI: bpHealth}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,57 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/prowlarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHealth(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   "/api/v1/health", // Prowlarr's API is v1.
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"source":"IndexerStatusCheck","type":"warning",` +
+				`"message":"Indexers unavailable due to failures: Nyaa",` +
+				`"wikiUrl":"https://wiki.servarr.com/prowlarr/system#indexers-are-unavailable-due-to-failures"}]`,
+			WithResponse: []*starr.HealthCheck{{
+				Source:  "IndexerStatusCheck",
+				Type:    "warning",
+				Message: "Indexers unavailable due to failures: Nyaa",
+				WikiURL: "https://wiki.servarr.com/prowlarr/system#indexers-are-unavailable-due-to-failures",
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "health"),
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.HealthCheck(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHealth()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
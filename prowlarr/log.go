@@ -0,0 +1,110 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpLog = APIver + "/log"
+
+// GetLogs returns a single page of Prowlarr log entries from the /api/v1/log endpoint.
+// The page size and number is configurable with the input request parameters.
+// Filter by log level by setting the "level" value, ie. params.Set("level", "error").
+func (p *Prowlarr) GetLogs(params *starr.PageReq) (*starr.Logs, error) {
+	return p.GetLogsContext(context.Background(), params)
+}
+
+// GetLogsContext returns a single page of Prowlarr log entries from the /api/v1/log endpoint.
+// The page size and number is configurable with the input request parameters.
+func (p *Prowlarr) GetLogsContext(ctx context.Context, params *starr.PageReq) (*starr.Logs, error) {
+	var output starr.Logs
+
+	params.CheckSet("sortKey", "time")
+	params.CheckSet("sortDirection", string(starr.SortDescend))
+
+	req := starr.Request{URI: bpLog, Query: params.Params()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetLogFiles returns the list of application log files Prowlarr has on disk.
+func (p *Prowlarr) GetLogFiles() ([]*starr.LogFile, error) {
+	return p.GetLogFilesContext(context.Background())
+}
+
+// GetLogFilesContext returns the list of application log files Prowlarr has on disk.
+func (p *Prowlarr) GetLogFilesContext(ctx context.Context) ([]*starr.LogFile, error) {
+	var output []*starr.LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetUpdateLogFiles returns the list of updater log files Prowlarr has on disk.
+func (p *Prowlarr) GetUpdateLogFiles() ([]*starr.LogFile, error) {
+	return p.GetUpdateLogFilesContext(context.Background())
+}
+
+// GetUpdateLogFilesContext returns the list of updater log files Prowlarr has on disk.
+func (p *Prowlarr) GetUpdateLogFilesContext(ctx context.Context) ([]*starr.LogFile, error) {
+	var output []*starr.LogFile
+
+	req := starr.Request{URI: path.Join(bpLog, "file", "update")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DownloadLogFile streams the named application log file into the provided writer.
+// Get file names from GetLogFiles. Returns the number of bytes written.
+func (p *Prowlarr) DownloadLogFile(filename string, output io.Writer) (int64, error) {
+	return p.DownloadLogFileContext(context.Background(), filename, output)
+}
+
+// DownloadLogFileContext streams the named application log file into the provided writer.
+// Get file names from GetLogFiles. Returns the number of bytes written.
+func (p *Prowlarr) DownloadLogFileContext(ctx context.Context, filename string, output io.Writer) (int64, error) {
+	return p.downloadLogFile(ctx, path.Join(bpLog, "file", path.Base(filename)), output)
+}
+
+// DownloadUpdateLogFile streams the named updater log file into the provided writer.
+// Get file names from GetUpdateLogFiles. Returns the number of bytes written.
+func (p *Prowlarr) DownloadUpdateLogFile(filename string, output io.Writer) (int64, error) {
+	return p.DownloadUpdateLogFileContext(context.Background(), filename, output)
+}
+
+// DownloadUpdateLogFileContext streams the named updater log file into the provided writer.
+// Get file names from GetUpdateLogFiles. Returns the number of bytes written.
+func (p *Prowlarr) DownloadUpdateLogFileContext(ctx context.Context, filename string, output io.Writer) (int64, error) {
+	return p.downloadLogFile(ctx, path.Join(bpLog, "file", "update", path.Base(filename)), output)
+}
+
+func (p *Prowlarr) downloadLogFile(ctx context.Context, uri string, output io.Writer) (int64, error) {
+	req := starr.Request{URI: starr.SetAPIPath(uri)}
+
+	resp, err := p.Get(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	size, err := io.Copy(output, resp.Body)
+	if err != nil {
+		return size, fmt.Errorf("writing log file (%s): %w", &req, err)
+	}
+
+	return size, nil
+}
@@ -64,13 +64,13 @@ func (p *Prowlarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, e
 }
 
 // GetBackupFiles returns all available Prowlarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (p *Prowlarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return p.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFiles returns all available Prowlarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (p *Prowlarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
@@ -93,6 +93,19 @@ func (r *Radarr) GetBlockListPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// BlockListPager returns a pager to walk the Radarr block list one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (r *Radarr) BlockListPager(params *starr.PageReq) *starr.Pager[*BlockListRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*BlockListRecord, int, error) {
+		page, err := r.GetBlockListPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteBlockList removes a single block list item.
 func (r *Radarr) DeleteBlockList(listID int64) error {
 	return r.DeleteBlockListContext(context.Background(), listID)
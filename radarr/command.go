@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/BSFishy/starr"
@@ -13,10 +14,13 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v3/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Use the *Command() constructors, like MoviesSearchCommand(), to build a valid request.
 type CommandRequest struct {
 	Name     string  `json:"name"`
 	MovieIDs []int64 `json:"movieIds,omitempty"`
+	MovieID  int64   `json:"movieId,omitempty"`
+	Files    []int64 `json:"files,omitempty"` // RenameFiles only
+	Path     string  `json:"path,omitempty"`  // DownloadedMoviesScan only
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -69,6 +73,10 @@ func (r *Radarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 		return &output, nil
 	}
 
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(cmd); err != nil {
 		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCommand, err)
@@ -81,3 +89,75 @@ func (r *Radarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 
 	return &output, nil
 }
+
+// GetCommandStatus returns the status of an already started command.
+func (r *Radarr) GetCommandStatus(commandID int64) (*CommandResponse, error) {
+	return r.GetCommandStatusContext(context.Background(), commandID)
+}
+
+// GetCommandStatusContext returns the status of an already started command.
+func (r *Radarr) GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if commandID == 0 {
+		return &output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpCommand, starr.Str(commandID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// WaitForCommand polls a command's status until it is completed, failed or aborted.
+// The final command status is returned. A command that does not complete
+// returns a *starr.CommandError, which matches starr.ErrCommandFailed.
+// pollInterval defaults to starr.DefaultCommandPollInterval when zero.
+func (r *Radarr) WaitForCommand(commandID int64, pollInterval time.Duration) (*CommandResponse, error) {
+	return r.WaitForCommandContext(context.Background(), commandID, pollInterval)
+}
+
+// WaitForCommandContext polls a command's status until it is completed, failed or aborted.
+// Use a context with a deadline to limit how long this waits. See WaitForCommand for more.
+func (r *Radarr) WaitForCommandContext(
+	ctx context.Context,
+	commandID int64,
+	pollInterval time.Duration,
+) (*CommandResponse, error) {
+	if commandID < 1 {
+		return nil, fmt.Errorf("%w: invalid command ID: %d", starr.ErrRequestError, commandID)
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = starr.DefaultCommandPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := r.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Status == starr.CommandCompleted {
+			return status, nil
+		} else if starr.CommandDone(status.Status) {
+			return status, &starr.CommandError{
+				ID:      status.ID,
+				Name:    status.Name,
+				Status:  status.Status,
+				Message: status.Message,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for command %d: %w", commandID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
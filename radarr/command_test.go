@@ -2,7 +2,9 @@ package radarr_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"path"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -148,3 +150,31 @@ func TestSendCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, path.Join("/", starr.API, radarr.APIver, "command", "12"), req.URL.Path)
+
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			_, _ = writer.Write([]byte(`{"id":12,"name":"RefreshMovie","status":"queued"}`))
+		case 2:
+			_, _ = writer.Write([]byte(`{"id":12,"name":"RefreshMovie","status":"started"}`))
+		default:
+			_, _ = writer.Write([]byte(`{"id":12,"name":"RefreshMovie","status":"failed","message":"oops"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	output, err := client.WaitForCommand(12, time.Millisecond)
+	require.ErrorIs(t, err, starr.ErrCommandFailed)
+	require.ErrorIs(t, err, &starr.CommandError{Status: starr.CommandFailed})
+	assert.Equal(t, "command 12 (RefreshMovie) failed: oops", err.Error())
+	assert.Equal(t, starr.CommandFailed, output.Status)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+}
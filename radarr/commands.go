@@ -0,0 +1,122 @@
+package radarr
+
+import (
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+/* This file contains constructors for the commands Radarr supports. Use them with SendCommand. */
+
+// Validate returns an error if the command is missing a field its name requires.
+// SendCommand calls this before sending the request.
+func (c *CommandRequest) Validate() error {
+	var missing string
+
+	switch c.Name {
+	case "MoviesSearch":
+		if len(c.MovieIDs) == 0 {
+			missing = "movieIds"
+		}
+	case "RenameMovie":
+		if len(c.MovieIDs) == 0 {
+			missing = "movieIds"
+		}
+	case "RenameFiles":
+		if c.MovieID < 1 {
+			missing = "movieId"
+		} else if len(c.Files) == 0 {
+			missing = "files"
+		}
+	case "DownloadedMoviesScan":
+		if c.Path == "" {
+			missing = "path"
+		}
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%w: %s command requires %s", starr.ErrRequestError, c.Name, missing)
+	}
+
+	return nil
+}
+
+// MoviesSearchCommand searches for the provided movies.
+func MoviesSearchCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "MoviesSearch", MovieIDs: movieIDs}
+}
+
+// MissingMoviesSearchCommand searches for all missing monitored movies.
+func MissingMoviesSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingMoviesSearch"}
+}
+
+// CutoffUnmetMoviesSearchCommand searches for upgrades to all movies that have not met their quality cutoff.
+func CutoffUnmetMoviesSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetMoviesSearch"}
+}
+
+// RefreshMovieCommand refreshes metadata and rescans files for movies. Provide no IDs to refresh every movie.
+func RefreshMovieCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshMovie", MovieIDs: movieIDs}
+}
+
+// RescanMovieCommand rescans the files on disk for a movie. Use 0 to rescan every movie.
+func RescanMovieCommand(movieID int64) *CommandRequest {
+	return &CommandRequest{Name: "RescanMovie", MovieID: movieID}
+}
+
+// RenameMoviesCommand renames every file in the provided movies.
+func RenameMoviesCommand(movieIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameMovie", MovieIDs: movieIDs}
+}
+
+// RenameFilesCommand renames the provided movie files that belong to a movie.
+func RenameFilesCommand(movieID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", MovieID: movieID, Files: fileIDs}
+}
+
+// DownloadedMoviesScanCommand imports the completed downloads found in a folder.
+func DownloadedMoviesScanCommand(path string) *CommandRequest {
+	return &CommandRequest{Name: "DownloadedMoviesScan", Path: path}
+}
+
+// RssSyncCommand checks every RSS enabled indexer for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks the download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. See CreateBackup for a method that waits for it.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: starr.BackupCommand}
+}
+
+// CheckHealthCommand runs all health checks.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// HousekeepingCommand runs the database housekeeping tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
+
+// ClearBlocklistCommand removes every item from the block list.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand deletes old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
@@ -0,0 +1,92 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCustomFormats(t *testing.T) {
+	t.Parallel()
+
+	spec := func(value string) []*starr.CustomFormatFileSpec {
+		return []*starr.CustomFormatFileSpec{{
+			Name:           "title",
+			Implementation: "ReleaseTitleSpecification",
+			Fields:         []*starr.FieldInput{{Name: "value", Value: value}},
+		}}
+	}
+	formats := []*starr.CustomFormatFile{
+		{Name: "x265", Specifications: spec("265")},
+		{Name: "HDR", Specifications: spec("HDR10")},
+		{Name: "DV", TrashScores: map[string]int64{"default": 20}, Specifications: spec("DV")},
+	}
+	existing := `[{"id":1,"name":"X265","includeCustomFormatWhenRenaming":false,"specifications":[` +
+		`{"name":"title","implementation":"ReleaseTitleSpecification","negate":false,"required":false,` +
+		`"fields":[{"name":"value","value":"265"}]}]},` +
+		`{"id":2,"name":"HDR","includeCustomFormatWhenRenaming":false,"specifications":[` +
+		`{"name":"title","implementation":"ReleaseTitleSpecification","negate":false,"required":false,` +
+		`"fields":[{"name":"value","value":"HDR"}]}]}]`
+	specBody := func(value string) string {
+		return `"specifications":[{"name":"title","implementation":"ReleaseTitleSpecification",` +
+			`"negate":false,"required":false,"fields":[{"name":"value","value":"` + value + `"}]}]`
+	}
+
+	mockServer := starrtest.GetMockSequence(t,
+		&starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "customFormat"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   existing,
+		},
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "customFormat", "2"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"id":2,"name":"HDR","includeCustomFormatWhenRenaming":false,` + specBody("HDR10") + "}\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `{"id":2,"name":"HDR"}`,
+		},
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "customFormat"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"name":"DV","includeCustomFormatWhenRenaming":false,` + specBody("DV") + "}\n",
+			ResponseStatus:  http.StatusCreated,
+			ResponseBody:    `{"id":3,"name":"DV"}`,
+		},
+		&starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "qualityProfile"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":7,"name":"HD","formatItems":[{"format":1,"name":"X265","score":-10},` +
+				`{"format":2,"name":"HDR","score":0}]}]`,
+		},
+		&starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "qualityProfile", "7"),
+			ExpectedMethod: http.MethodPut,
+			ExpectedRequest: `{"id":7,"name":"HD","upgradeAllowed":false,"cutoff":0,"minFormatScore":0,` +
+				`"minUpgradeFormatScore":0,"cutoffFormatScore":0,"formatItems":[{"format":1,"name":"X265","score":-10},` +
+				`{"format":2,"name":"HDR","score":0},{"format":3,"name":"DV","score":20}]}` + "\n",
+			ResponseStatus: http.StatusAccepted,
+			ResponseBody:   `{"id":7,"name":"HD"}`,
+		},
+	)
+
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.SyncCustomFormats(formats, &starr.ProfileScores{Profile: "HD"})
+	require.NoError(t, err)
+	assert.Equal(t, &starr.FormatSync{
+		Created:   []string{"DV"},
+		Updated:   []string{"HDR"},
+		Unchanged: []string{"x265"},
+		Profiles: []*starr.ProfileScoreChange{{
+			Profile: "HD",
+			Scores:  []*starr.ScoreChange{{Name: "DV", Old: 0, New: 20}},
+		}},
+	}, output, "a format whose name only differs in case must be left unchanged")
+}
@@ -0,0 +1,28 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpHealth = APIver + "/health"
+
+// GetHealth returns all active health check problems from the /api/v3/health endpoint.
+// An empty list means Radarr found no problems with itself.
+func (r *Radarr) GetHealth() ([]*starr.HealthCheck, error) {
+	return r.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns all active health check problems from the /api/v3/health endpoint.
+func (r *Radarr) GetHealthContext(ctx context.Context) ([]*starr.HealthCheck, error) {
+	var output []*starr.HealthCheck
+
+	req := starr.Request{URI: bpHealth}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
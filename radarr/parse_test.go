@@ -0,0 +1,60 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "parse") + "?title=Movie.2020.1080p-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"title":"Movie.2020.1080p-GRP","movie":{"id":4,"title":"Movie"},` +
+				`"customFormats":[{"id":2,"name":"HD"}],"customFormatScore":25,` +
+				`"parsedMovieInfo":{"movieTitle":"Movie","year":2020,"releaseGroup":"GRP"}}`,
+			WithRequest: "Movie.2020.1080p-GRP",
+			WithResponse: &radarr.ParseOutput{
+				Title:             "Movie.2020.1080p-GRP",
+				Movie:             &radarr.Movie{ID: 4, Title: "Movie"},
+				CustomFormats:     []*radarr.CustomFormatOutput{{ID: 2, Name: "HD"}},
+				CustomFormatScore: 25,
+				ParsedMovieInfo:   &radarr.ParsedMovieInfo{MovieTitle: "Movie", Year: 2020, ReleaseGroup: "GRP"},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "parse") + "?title=Movie.2020.1080p-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    "Movie.2020.1080p-GRP",
+			WithResponse:   (*radarr.ParseOutput)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse(test.WithRequest.(string))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
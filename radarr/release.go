@@ -0,0 +1,194 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+const (
+	bpRelease     = APIver + "/release"
+	bpReleasePush = bpRelease + "/push"
+)
+
+// Release is the output from the Radarr release endpoint.
+type Release struct {
+	ID                  int64                 `json:"id"`
+	GUID                string                `json:"guid"`
+	Quality             *starr.Quality        `json:"quality"`
+	CustomFormats       []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore   int64                 `json:"customFormatScore"`
+	QualityWeight       int64                 `json:"qualityWeight"`
+	Age                 int64                 `json:"age"`
+	AgeHours            float64               `json:"ageHours"`
+	AgeMinutes          float64               `json:"ageMinutes"`
+	Size                int64                 `json:"size"`
+	IndexerID           int64                 `json:"indexerId"`
+	Indexer             string                `json:"indexer"`
+	ReleaseGroup        string                `json:"releaseGroup"`
+	SubGroup            string                `json:"subGroup"`
+	ReleaseHash         string                `json:"releaseHash"`
+	Title               string                `json:"title"`
+	SceneSource         bool                  `json:"sceneSource"`
+	MovieTitles         []string              `json:"movieTitles"`
+	Languages           []*starr.Value        `json:"languages"`
+	MappedMovieID       int64                 `json:"mappedMovieId"`
+	Approved            bool                  `json:"approved"`
+	TemporarilyRejected bool                  `json:"temporarilyRejected"`
+	Rejected            bool                  `json:"rejected"`
+	TmdbID              int64                 `json:"tmdbId"`
+	ImdbID              string                `json:"imdbId"`
+	Rejections          []string              `json:"rejections"`
+	PublishDate         time.Time             `json:"publishDate"`
+	CommentURL          string                `json:"commentUrl"`
+	DownloadURL         string                `json:"downloadUrl"`
+	InfoURL             string                `json:"infoUrl"`
+	MovieRequested      bool                  `json:"movieRequested"`
+	DownloadAllowed     bool                  `json:"downloadAllowed"`
+	ReleaseWeight       int64                 `json:"releaseWeight"`
+	Edition             string                `json:"edition"`
+	MagnetURL           string                `json:"magnetUrl"`
+	InfoHash            string                `json:"infoHash"`
+	Seeders             int                   `json:"seeders"`
+	Leechers            int                   `json:"leechers"`
+	Protocol            starr.Protocol        `json:"protocol"`
+	IndexerFlags        []string              `json:"indexerFlags"`
+	MovieID             int64                 `json:"movieId"`
+	DownloadClientID    int64                 `json:"downloadClientId"`
+	DownloadClient      string                `json:"downloadClient"`
+	ShouldOverride      bool                  `json:"shouldOverride"`
+}
+
+// SearchRelease is the input needed to search for releases through Radarr.
+type SearchRelease struct {
+	MovieID int64 `json:"movieId"`
+}
+
+// SearchRelease searches for and returns a list releases available for download.
+func (r *Radarr) SearchRelease(input *SearchRelease) ([]*Release, error) {
+	return r.SearchReleaseContext(context.Background(), input)
+}
+
+// SearchReleaseContext searches for and returns a list releases available for download.
+func (r *Radarr) SearchReleaseContext(ctx context.Context, input *SearchRelease) ([]*Release, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+	req.Query.Set("movieId", starr.Str(input.MovieID))
+
+	var output []*Release
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Grab is the output from the Grab* methods.
+type Grab struct {
+	Approved            bool           `json:"approved"`
+	DownloadAllowed     bool           `json:"downloadAllowed"`
+	MovieRequested      bool           `json:"movieRequested"`
+	TemporarilyRejected bool           `json:"temporarilyRejected"`
+	Rejected            bool           `json:"rejected"`
+	SceneSource         bool           `json:"sceneSource"`
+	AgeHours            float64        `json:"ageHours"`
+	AgeMinutes          float64        `json:"ageMinutes"`
+	Size                int64          `json:"size"`
+	Age                 int64          `json:"age"`
+	CustomFormatScore   int64          `json:"customFormatScore"`
+	IndexerID           int64          `json:"indexerId"`
+	QualityWeight       int64          `json:"qualityWeight"`
+	ReleaseWeight       int64          `json:"releaseWeight"`
+	TmdbID              int64          `json:"tmdbId"`
+	PublishDate         time.Time      `json:"publishDate"`
+	GUID                string         `json:"guid"`
+	Protocol            starr.Protocol `json:"protocol"`
+}
+
+// Grab adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (r *Radarr) Grab(guid string, indexerID int64) (*Grab, error) {
+	return r.GrabContext(context.Background(), guid, indexerID)
+}
+
+// GrabContext adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (r *Radarr) GrabContext(ctx context.Context, guid string, indexerID int64) (*Grab, error) {
+	return r.GrabReleaseContext(ctx, &Release{IndexerID: indexerID, GUID: guid})
+}
+
+// GrabRelease adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (r *Radarr) GrabRelease(release *Release) (*Grab, error) {
+	return r.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (r *Radarr) GrabReleaseContext(ctx context.Context, release *Release) (*Grab, error) {
+	grab := struct { // We only use/need the guid and indexerID from the release.
+		G string `json:"guid"`
+		I int64  `json:"indexerId"`
+	}{G: release.GUID, I: release.IndexerID}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&grab); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output Grab
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease is the input needed to push a release from an external source into Radarr.
+type PushRelease struct {
+	Title       string         `json:"title"`
+	DownloadURL string         `json:"downloadUrl"`
+	Protocol    starr.Protocol `json:"protocol"`
+	PublishDate time.Time      `json:"publishDate"`
+	Size        int64          `json:"size"`
+	Indexer     string         `json:"indexer"`
+}
+
+// PushRelease sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (r *Radarr) PushRelease(input *PushRelease) ([]*Release, error) {
+	return r.PushReleaseContext(context.Background(), input)
+}
+
+// PushReleaseContext sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (r *Radarr) PushReleaseContext(ctx context.Context, input *PushRelease) ([]*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleasePush, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: bpReleasePush, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	// Newer versions return a list of decisions, older versions return only one.
+	var decisions []*Release
+	if err := json.Unmarshal(output, &decisions); err == nil {
+		return decisions, nil
+	}
+
+	var decision Release
+	if err := json.Unmarshal(output, &decision); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(%s): %w", bpReleasePush, err)
+	}
+
+	return []*Release{&decision}, nil
+}
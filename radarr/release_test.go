@@ -0,0 +1,81 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "release") + "?movieId=12",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid":"abc","indexerId":3,"indexer":"Tracker","title":"Movie.2020.1080p",` +
+				`"customFormatScore":150,"seeders":40,"rejected":true,"rejections":["Not an upgrade"],"movieId":12}]`,
+			WithRequest: &radarr.SearchRelease{MovieID: 12},
+			WithResponse: []*radarr.Release{{
+				GUID:              "abc",
+				IndexerID:         3,
+				Indexer:           "Tracker",
+				Title:             "Movie.2020.1080p",
+				CustomFormatScore: 150,
+				Seeders:           40,
+				Rejected:          true,
+				Rejections:        []string{"Not an upgrade"},
+				MovieID:           12,
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "release") + "?movieId=12",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &radarr.SearchRelease{MovieID: 12},
+			WithResponse:   []*radarr.Release(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*radarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrab(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "release"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.Grab("abc", 3)
+	require.NoError(t, err)
+	assert.Equal(t, &radarr.Grab{GUID: "abc", IndexerID: 3, Approved: true}, output)
+}
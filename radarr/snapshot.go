@@ -0,0 +1,389 @@
+package radarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BSFishy/starr"
+)
+
+// Snapshot is a copy of the settings in a Radarr instance. Create one with GetSnapshot or
+// ExportSnapshot, and copy it into another instance with ImportSnapshot.
+// Indexers, download clients, notifications, root folders and delay profiles are not included.
+type Snapshot struct {
+	starr.SnapshotHeader
+	Tags                 []*starr.Tag               `json:"tags"`
+	Naming               *Naming                    `json:"naming"`
+	MediaManagement      *MediaManagement           `json:"mediaManagement"`
+	IndexerConfig        *IndexerConfig             `json:"indexerConfig"`
+	DownloadClientConfig *DownloadClientConfig      `json:"downloadClientConfig"`
+	QualityDefinitions   []*QualityDefinition       `json:"qualityDefinitions"`
+	CustomFormats        []*CustomFormatOutput      `json:"customFormats"`
+	QualityProfiles      []*QualityProfile          `json:"qualityProfiles"`
+	Restrictions         []*Restriction             `json:"restrictions"`
+	ReleaseProfiles      []*ReleaseProfile          `json:"releaseProfiles"`
+	RemotePathMappings   []*starr.RemotePathMapping `json:"remotePathMappings"`
+}
+
+// GetSnapshot returns a copy of the settings in Radarr.
+func (r *Radarr) GetSnapshot() (*Snapshot, error) {
+	return r.GetSnapshotContext(context.Background())
+}
+
+// GetSnapshotContext returns a copy of the settings in Radarr.
+func (r *Radarr) GetSnapshotContext(ctx context.Context) (*Snapshot, error) {
+	var (
+		snap = &Snapshot{SnapshotHeader: starr.NewSnapshotHeader(starr.Radarr)}
+		err  error
+	)
+
+	if snap.Tags, err = r.GetTagsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.Naming, err = r.GetNamingContext(ctx); err != nil {
+		return nil, err
+	} else if snap.MediaManagement, err = r.GetMediaManagementContext(ctx); err != nil {
+		return nil, err
+	} else if snap.IndexerConfig, err = r.GetIndexerConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.DownloadClientConfig, err = r.GetDownloadClientConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityDefinitions, err = r.GetQualityDefinitionsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.CustomFormats, err = r.GetCustomFormatsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityProfiles, err = r.GetQualityProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.Restrictions, err = r.GetRestrictionsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.ReleaseProfiles, err = r.GetReleaseProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.RemotePathMappings, err = r.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// ExportSnapshot writes a JSON snapshot of the settings in Radarr.
+func (r *Radarr) ExportSnapshot(output io.Writer) error {
+	return r.ExportSnapshotContext(context.Background(), output)
+}
+
+// ExportSnapshotContext writes a JSON snapshot of the settings in Radarr.
+func (r *Radarr) ExportSnapshotContext(ctx context.Context, output io.Writer) error {
+	snap, err := r.GetSnapshotContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snap); err != nil {
+		return fmt.Errorf("json.Marshal(snapshot): %w", err)
+	}
+
+	return nil
+}
+
+// ImportSnapshot reads a JSON snapshot, and applies it to Radarr with ApplySnapshot.
+func (r *Radarr) ImportSnapshot(input io.Reader) error {
+	return r.ImportSnapshotContext(context.Background(), input)
+}
+
+// ImportSnapshotContext reads a JSON snapshot, and applies it to Radarr with ApplySnapshot.
+func (r *Radarr) ImportSnapshotContext(ctx context.Context, input io.Reader) error {
+	var snap Snapshot
+	if err := json.NewDecoder(input).Decode(&snap); err != nil {
+		return fmt.Errorf("json.Unmarshal(snapshot): %w", err)
+	}
+
+	return r.ApplySnapshotContext(ctx, &snap)
+}
+
+// ApplySnapshot copies the settings in a snapshot into Radarr.
+func (r *Radarr) ApplySnapshot(snap *Snapshot) error {
+	return r.ApplySnapshotContext(context.Background(), snap)
+}
+
+// ApplySnapshotContext copies the settings in a snapshot into Radarr. Tags, custom formats, quality
+// profiles and release profiles are matched by name, and created when missing. IDs and tag references
+// are remapped to the IDs in this instance. Release profiles lose their indexer, because indexers are
+// not part of a snapshot. Restrictions are added unless an identical one exists. Stops at the first error.
+func (r *Radarr) ApplySnapshotContext(ctx context.Context, snap *Snapshot) error {
+	if err := snap.Check(starr.Radarr); err != nil {
+		return err
+	}
+
+	existing, err := r.GetTagsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := starr.ImportTags(ctx, existing, snap.Tags, r.AddTagContext)
+	if err != nil {
+		return err
+	}
+
+	if err := r.applySnapshotConfig(ctx, snap); err != nil {
+		return err
+	}
+
+	formats, err := r.applySnapshotFormats(ctx, snap.CustomFormats)
+	if err != nil {
+		return err
+	}
+
+	if err := r.applySnapshotProfiles(ctx, snap.QualityProfiles, formats); err != nil {
+		return err
+	}
+
+	if err := r.applySnapshotRestrictions(ctx, snap, tags); err != nil {
+		return err
+	}
+
+	return r.applySnapshotMappings(ctx, snap.RemotePathMappings)
+}
+
+// applySnapshotConfig updates the settings that exist once per instance.
+func (r *Radarr) applySnapshotConfig(ctx context.Context, snap *Snapshot) error {
+	if snap.Naming != nil {
+		if _, err := r.UpdateNamingContext(ctx, snap.Naming); err != nil {
+			return fmt.Errorf("importing naming: %w", err)
+		}
+	}
+
+	if snap.MediaManagement != nil {
+		if _, err := r.UpdateMediaManagementContext(ctx, snap.MediaManagement); err != nil {
+			return fmt.Errorf("importing media management: %w", err)
+		}
+	}
+
+	if snap.IndexerConfig != nil {
+		if _, err := r.UpdateIndexerConfigContext(ctx, snap.IndexerConfig); err != nil {
+			return fmt.Errorf("importing indexer config: %w", err)
+		}
+	}
+
+	if snap.DownloadClientConfig != nil {
+		if _, err := r.UpdateDownloadClientConfigContext(ctx, snap.DownloadClientConfig); err != nil {
+			return fmt.Errorf("importing download client config: %w", err)
+		}
+	}
+
+	if len(snap.QualityDefinitions) == 0 {
+		return nil
+	}
+
+	definitions, err := r.GetQualityDefinitionsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Quality IDs are the same in every instance, but definition IDs may not be.
+	ids := make(map[int64]int64, len(definitions))
+	for _, def := range definitions {
+		if def.Quality != nil {
+			ids[def.Quality.ID] = def.ID
+		}
+	}
+
+	update := []*QualityDefinition{}
+
+	for _, def := range snap.QualityDefinitions {
+		if def.Quality == nil {
+			continue
+		}
+
+		if id, ok := ids[def.Quality.ID]; ok {
+			copied := *def
+			copied.ID = id
+			update = append(update, &copied)
+		}
+	}
+
+	if _, err := r.UpdateQualityDefinitionsContext(ctx, update); err != nil {
+		return fmt.Errorf("importing quality definitions: %w", err)
+	}
+
+	return nil
+}
+
+// applySnapshotFormats creates or updates custom formats by name, and returns a map of snapshot IDs to new IDs.
+func (r *Radarr) applySnapshotFormats(ctx context.Context, formats []*CustomFormatOutput) (map[int64]int64, error) {
+	existing, err := r.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]int64, len(existing))
+	for _, format := range existing {
+		names[strings.ToLower(format.Name)] = format.ID
+	}
+
+	ids := make(map[int64]int64, len(formats))
+
+	for _, format := range formats {
+		var input CustomFormatInput
+		if err := convert(format, &input); err != nil {
+			return nil, err
+		}
+
+		input.ID = names[strings.ToLower(format.Name)]
+
+		var output *CustomFormatOutput
+		if input.ID == 0 {
+			output, err = r.AddCustomFormatContext(ctx, &input)
+		} else {
+			output, err = r.UpdateCustomFormatContext(ctx, &input)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("importing custom format '%s': %w", format.Name, err)
+		}
+
+		ids[format.ID] = output.ID
+	}
+
+	return ids, nil
+}
+
+// applySnapshotProfiles creates or updates quality profiles by name, with their format scores remapped.
+// Every custom format in the instance is listed in each profile; formats not in the snapshot keep their scores.
+func (r *Radarr) applySnapshotProfiles(ctx context.Context, profiles []*QualityProfile, formats map[int64]int64) error {
+	existing, err := r.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customFormats, err := r.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := make([]*starr.Value, len(customFormats))
+	for idx, format := range customFormats {
+		values[idx] = &starr.Value{ID: format.ID, Name: format.Name}
+	}
+
+	names := make(map[string]*QualityProfile, len(existing))
+	for _, profile := range existing {
+		names[strings.ToLower(profile.Name)] = profile
+	}
+
+	for _, profile := range profiles {
+		var have []*starr.FormatItem
+
+		copied := *profile
+		copied.ID = 0
+
+		if found := names[strings.ToLower(profile.Name)]; found != nil {
+			copied.ID, have = found.ID, found.FormatItems
+		}
+
+		copied.FormatItems = starr.RemapFormatItems(values, have, profile.FormatItems, formats)
+
+		if copied.ID == 0 {
+			_, err = r.AddQualityProfileContext(ctx, &copied)
+		} else {
+			_, err = r.UpdateQualityProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing quality profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotRestrictions imports restrictions and release profiles, with their tags remapped.
+func (r *Radarr) applySnapshotRestrictions(ctx context.Context, snap *Snapshot, tags map[int]int) error {
+	restrictions, err := r.GetRestrictionsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, restriction := range snap.Restrictions {
+		copied := *restriction
+		copied.ID, copied.Tags = 0, starr.RemapTags(restriction.Tags, tags)
+
+		if !hasRestriction(restrictions, &copied) {
+			if _, err := r.AddRestrictionContext(ctx, &copied); err != nil {
+				return fmt.Errorf("importing restriction: %w", err)
+			}
+		}
+	}
+
+	profiles, err := r.GetReleaseProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]int64, len(profiles))
+	for _, profile := range profiles {
+		names[strings.ToLower(profile.Name)] = profile.ID
+	}
+
+	for _, profile := range snap.ReleaseProfiles {
+		copied := *profile
+		copied.ID, copied.IndexerID = names[strings.ToLower(profile.Name)], 0
+		copied.Tags = starr.RemapTags(profile.Tags, tags)
+
+		if copied.ID == 0 {
+			_, err = r.AddReleaseProfileContext(ctx, &copied)
+		} else {
+			_, err = r.UpdateReleaseProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing release profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func hasRestriction(restrictions []*Restriction, find *Restriction) bool {
+	for _, restriction := range restrictions {
+		if restriction.Required == find.Required && restriction.Ignored == find.Ignored &&
+			fmt.Sprint(restriction.Tags) == fmt.Sprint(find.Tags) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applySnapshotMappings creates or updates remote path mappings, matched by host and remote path.
+func (r *Radarr) applySnapshotMappings(ctx context.Context, mappings []*starr.RemotePathMapping) error {
+	existing, err := r.GetRemotePathMappingsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]int64, len(existing))
+	for _, mapping := range existing {
+		ids[mapping.Host+mapping.RemotePath] = mapping.ID
+	}
+
+	for _, mapping := range mappings {
+		copied := *mapping
+		copied.ID = ids[mapping.Host+mapping.RemotePath]
+
+		if copied.ID == 0 {
+			_, err = r.AddRemotePathMappingContext(ctx, &copied)
+		} else {
+			_, err = r.UpdateRemotePathMappingContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing remote path mapping '%s': %w", mapping.RemotePath, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package radarr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotBody returns the request body a client sends for input.
+func snapshotBody(t *testing.T, input interface{}) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(input))
+
+	return body.String()
+}
+
+func snapshotGet(uri, response string) *starrtest.MockData {
+	return &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, radarr.APIver, uri),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   response,
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":2,"label":"4k"}]`),
+		snapshotGet("config/naming", `{"id":1,"renameMovies":true}`),
+		snapshotGet("config/mediaManagement", `{"id":1}`),
+		snapshotGet("config/indexer", `{"id":1}`),
+		snapshotGet("config/downloadClient", `{"id":1}`),
+		snapshotGet("qualityDefinition", `[]`),
+		snapshotGet("customFormat", `[{"id":9,"name":"HDR"}]`),
+		snapshotGet("qualityProfile", `[{"id":3,"name":"HD","formatItems":[{"format":9,"name":"HDR","score":100}]}]`),
+		snapshotGet("restriction", `[]`),
+		snapshotGet("releaseProfile", `[]`),
+		snapshotGet("remotePathMapping", `[]`),
+	)
+
+	var output bytes.Buffer
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, client.ExportSnapshot(&output))
+
+	var snap radarr.Snapshot
+	require.NoError(t, json.Unmarshal(output.Bytes(), &snap))
+	assert.Equal(t, starr.Radarr, snap.App)
+	assert.Equal(t, starr.SnapshotVersion, snap.Version)
+	assert.Equal(t, []*starr.Tag{{ID: 2, Label: "4k"}}, snap.Tags)
+	assert.True(t, snap.Naming.RenameMovies)
+	assert.Equal(t, int64(9), snap.QualityProfiles[0].FormatItems[0].Format)
+}
+
+func TestApplySnapshot(t *testing.T) {
+	t.Parallel()
+
+	snap := &radarr.Snapshot{
+		SnapshotHeader: starr.NewSnapshotHeader(starr.Radarr),
+		Tags:           []*starr.Tag{{ID: 5, Label: "4K"}},
+		CustomFormats:  []*radarr.CustomFormatOutput{{ID: 8, Name: "HDR"}},
+		QualityProfiles: []*radarr.QualityProfile{{
+			ID:          1,
+			Name:        "HD",
+			FormatItems: []*starr.FormatItem{{Format: 8, Name: "HDR", Score: 100}},
+		}},
+		Restrictions:    []*radarr.Restriction{{ID: 4, Tags: []int{5}, Required: "x265"}},
+		ReleaseProfiles: []*radarr.ReleaseProfile{{ID: 6, Name: "Block", IndexerID: 3, Tags: []int{5}}},
+	}
+
+	server := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":2,"label":"4k"}]`),
+		snapshotGet("customFormat", `[{"id":1,"name":"x265"}]`),
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "customFormat"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: snapshotBody(t, &radarr.CustomFormatInput{Name: "HDR"}),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"id":9,"name":"HDR"}`,
+		},
+		snapshotGet("qualityProfile", `[{"id":3,"name":"hd","formatItems":[{"format":1,"name":"x265","score":-10}]}]`),
+		snapshotGet("customFormat", `[{"id":1,"name":"x265"},{"id":9,"name":"HDR"}]`),
+		&starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "qualityProfile", "3"),
+			ExpectedMethod: http.MethodPut,
+			ExpectedRequest: snapshotBody(t, &radarr.QualityProfile{
+				ID:   3,
+				Name: "HD",
+				FormatItems: []*starr.FormatItem{
+					{Format: 1, Name: "x265", Score: -10}, // not in the snapshot, so the score is kept.
+					{Format: 9, Name: "HDR", Score: 100},  // remapped from format 8.
+				},
+			}),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"id":3}`,
+		},
+		snapshotGet("restriction", `[]`),
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "restriction"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: snapshotBody(t, &radarr.Restriction{Tags: []int{2}, Required: "x265"}),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"id":1}`,
+		},
+		snapshotGet("releaseProfile", `[]`),
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "releaseProfile"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: snapshotBody(t, &radarr.ReleaseProfile{Name: "Block", Tags: []int{2}}),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"id":1}`,
+		},
+		snapshotGet("remotePathMapping", `[]`),
+	)
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, client.ApplySnapshot(snap))
+}
+
+func TestImportSnapshotWrongApp(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t) // no requests may be made.
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	err := client.ImportSnapshot(strings.NewReader(`{"version":1,"app":"Whisparr"}`))
+	require.ErrorIs(t, err, starr.ErrSnapshotApp)
+}
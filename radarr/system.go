@@ -62,13 +62,13 @@ func (r *Radarr) GetSystemStatusContext(ctx context.Context) (*SystemStatus, err
 }
 
 // GetBackupFiles returns all available Radarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (r *Radarr) GetBackupFiles() ([]*starr.BackupFile, error) {
 	return r.GetBackupFilesContext(context.Background())
 }
 
 // GetBackupFilesContext returns all available Radarr backup files.
-// Use GetBody to download a file using BackupFile.Path.
+// Use DownloadBackup to download a file using BackupFile.Path.
 func (r *Radarr) GetBackupFilesContext(ctx context.Context) ([]*starr.BackupFile, error) {
 	var output []*starr.BackupFile
 
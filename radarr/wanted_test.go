@@ -0,0 +1,101 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "missing") +
+				"?monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=movieMetadata.sortTitle",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"movieMetadata.sortTitle","sortDirection":"ascending",` +
+				`"totalRecords":1,"records":[{"id":7,"title":"Movie","monitored":true}]}`,
+			WithRequest: &starr.PageReq{},
+			WithResponse: &radarr.Wanted{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "movieMetadata.sortTitle",
+				SortDirection: "ascending",
+				TotalRecords:  1,
+				Records:       []*radarr.Movie{{ID: 7, Title: "Movie", Monitored: true}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "missing") +
+				"?monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=movieMetadata.sortTitle",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			params, _ := test.WithRequest.(*starr.PageReq)
+			output, err := client.GetWantedMissingPage(params, true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetWantedCutoff(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "cutoff") +
+			"?monitored=false&page=1&pageSize=2&sortDirection=ascending&sortKey=movieMetadata.sortTitle",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `{"page":1,"pageSize":2,"sortKey":"movieMetadata.sortTitle","sortDirection":"ascending",` +
+			`"totalRecords":2,"records":[{"id":1},{"id":2}]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoff(2, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.TotalRecords)
+	assert.Len(t, output.Records, 2)
+}
+
+func TestGetWantedCutoffPageNil(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "cutoff") +
+			"?monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=movieMetadata.sortTitle",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"page":1,"pageSize":10,"totalRecords":0,"records":[]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoffPage(nil, true)
+	require.NoError(t, err, "nil params must use the defaults")
+	assert.Empty(t, output.Records)
+}
@@ -0,0 +1,140 @@
+package readarr
+
+import (
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+/* This file contains constructors for the commands Readarr supports. Use them with SendCommand. */
+
+// Validate returns an error if the command is missing a field its name requires.
+// SendCommand calls this before sending the request.
+func (c *CommandRequest) Validate() error {
+	var missing string
+
+	switch c.Name {
+	case "BookSearch":
+		if len(c.BookIDs) == 0 {
+			missing = "bookIds"
+		}
+	case "AuthorSearch":
+		if c.AuthorID < 1 {
+			missing = "authorId"
+		}
+	case "RefreshBook":
+		if c.BookID < 1 {
+			missing = "bookId"
+		}
+	case "RenameFiles":
+		if c.AuthorID < 1 {
+			missing = "authorId"
+		} else if len(c.Files) == 0 {
+			missing = "files"
+		}
+	case "RenameAuthor":
+		if len(c.AuthorIDs) == 0 {
+			missing = "authorIds"
+		}
+	case "DownloadedBooksScan":
+		if c.Path == "" {
+			missing = "path"
+		}
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%w: %s command requires %s", starr.ErrRequestError, c.Name, missing)
+	}
+
+	return nil
+}
+
+// BookSearchCommand searches for the provided books.
+func BookSearchCommand(bookIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "BookSearch", BookIDs: bookIDs}
+}
+
+// AuthorSearchCommand searches for every monitored book by an author.
+func AuthorSearchCommand(authorID int64) *CommandRequest {
+	return &CommandRequest{Name: "AuthorSearch", AuthorID: authorID}
+}
+
+// MissingBookSearchCommand searches for all missing monitored books.
+func MissingBookSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "MissingBookSearch"}
+}
+
+// CutoffUnmetBookSearchCommand searches for upgrades to all books that have not met their quality cutoff.
+func CutoffUnmetBookSearchCommand() *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetBookSearch"}
+}
+
+// RefreshAuthorCommand refreshes metadata and rescans files for an author. Use 0 to refresh every author.
+func RefreshAuthorCommand(authorID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshAuthor", AuthorID: authorID}
+}
+
+// RefreshBookCommand refreshes metadata for a single book.
+func RefreshBookCommand(bookID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshBook", BookID: bookID}
+}
+
+// RescanFoldersCommand rescans the provided root folders. Provide no folders to rescan all of them.
+func RescanFoldersCommand(folders ...string) *CommandRequest {
+	return &CommandRequest{Name: "RescanFolders", Folders: folders}
+}
+
+// RenameFilesCommand renames the provided book files that belong to an author.
+func RenameFilesCommand(authorID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", AuthorID: authorID, Files: fileIDs}
+}
+
+// RenameAuthorCommand renames every book file by the provided authors.
+func RenameAuthorCommand(authorIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameAuthor", AuthorIDs: authorIDs}
+}
+
+// DownloadedBooksScanCommand imports the completed downloads found in a folder.
+func DownloadedBooksScanCommand(path string) *CommandRequest {
+	return &CommandRequest{Name: "DownloadedBooksScan", Path: path}
+}
+
+// RssSyncCommand checks every RSS enabled indexer for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks the download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. See CreateBackup for a method that waits for it.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: starr.BackupCommand}
+}
+
+// CheckHealthCommand runs all health checks.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// HousekeepingCommand runs the database housekeeping tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
+
+// ClearBlocklistCommand removes every item from the block list.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand deletes old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
@@ -117,6 +117,19 @@ func (r *Readarr) GetHistoryPageContext(ctx context.Context, params *starr.PageR
 	return &output, nil
 }
 
+// HistoryPager returns a pager to walk the Readarr History (grabs/failures/completed) one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (r *Readarr) HistoryPager(params *starr.PageReq) *starr.Pager[HistoryRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]HistoryRecord, int, error) {
+		page, err := r.GetHistoryPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (r *Readarr) Fail(historyID int64) error {
 	return r.FailContext(context.Background(), historyID)
@@ -0,0 +1,65 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpParse = APIver + "/parse"
+
+// AuthorTitleInfo is part of ParsedBookInfo.
+type AuthorTitleInfo struct {
+	Year             int    `json:"year"`
+	Title            string `json:"title"`
+	TitleWithoutYear string `json:"titleWithoutYear"`
+}
+
+// ParsedBookInfo is provided in ParseOutput when an item was properly parsed.
+type ParsedBookInfo struct {
+	ReleaseTitle     string           `json:"releaseTitle"`
+	BookTitle        string           `json:"bookTitle"`
+	AuthorName       string           `json:"authorName"`
+	AuthorTitleInfo  *AuthorTitleInfo `json:"authorTitleInfo"`
+	Quality          *starr.Quality   `json:"quality"`
+	ReleaseDate      string           `json:"releaseDate"`
+	Discography      bool             `json:"discography"`
+	DiscographyStart int              `json:"discographyStart"`
+	DiscographyEnd   int              `json:"discographyEnd"`
+	ReleaseGroup     string           `json:"releaseGroup"`
+	ReleaseHash      string           `json:"releaseHash"`
+	ReleaseVersion   string           `json:"releaseVersion"`
+}
+
+// ParseOutput is what you get from the parse endpoint when you provide a parsable title.
+type ParseOutput struct {
+	ID     int64   `json:"id"`
+	Title  string  `json:"title"`
+	Author *Author `json:"author"`
+	Books  []*Book `json:"books"`
+	// You need to check this for nil before accessing it.
+	// If the parse failed, this won't exist, and you won't get an error.
+	ParsedBookInfo *ParsedBookInfo `json:"parsedBookInfo"`
+}
+
+// Parse a release title into book info.
+func (r *Readarr) Parse(title string) (*ParseOutput, error) {
+	return r.ParseContext(context.Background(), title)
+}
+
+// ParseContext parses a release title into book info.
+// Author is nil when the title does not match an author in Readarr.
+func (r *Readarr) ParseContext(ctx context.Context, title string) (*ParseOutput, error) {
+	var output *ParseOutput
+
+	req := starr.Request{URI: bpParse, Query: make(url.Values)}
+	req.Query.Set("title", title)
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
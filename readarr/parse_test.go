@@ -0,0 +1,69 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "parse") + "?title=Author-Book-EPUB-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"title":"Author-Book-EPUB-GRP","author":{"id":2,"authorName":"Author"},` +
+				`"books":[{"id":5,"title":"Book","authorId":2}],` +
+				`"parsedBookInfo":{"bookTitle":"Book","authorName":"Author","releaseGroup":"GRP"}}`,
+			WithRequest: "Author-Book-EPUB-GRP",
+			WithResponse: &readarr.ParseOutput{
+				Title:          "Author-Book-EPUB-GRP",
+				Author:         &readarr.Author{ID: 2, AuthorName: "Author"},
+				Books:          []*readarr.Book{{ID: 5, Title: "Book", AuthorID: 2}},
+				ParsedBookInfo: &readarr.ParsedBookInfo{BookTitle: "Book", AuthorName: "Author", ReleaseGroup: "GRP"},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "200 not parsed",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "parse") + "?title=not-a-release",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"title":"not-a-release"}`,
+			WithRequest:    "not-a-release",
+			WithResponse:   &readarr.ParseOutput{Title: "not-a-release"},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "parse") + "?title=Author-Book-EPUB-GRP",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    "Author-Book-EPUB-GRP",
+			WithResponse:   (*readarr.ParseOutput)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Parse(test.WithRequest.(string))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
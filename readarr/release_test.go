@@ -0,0 +1,196 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release") + "?authorId=2&bookId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid":"abc","indexerId":3,"indexer":"Tracker","title":"Author-Book-EPUB",` +
+				`"customFormatScore":150,"seeders":40,"rejected":true,"rejections":["Not an upgrade"],` +
+				`"authorId":2,"bookId":5}]`,
+			WithRequest: &readarr.SearchRelease{AuthorID: 2, BookID: 5},
+			WithResponse: []*readarr.Release{{
+				GUID:              "abc",
+				IndexerID:         3,
+				Indexer:           "Tracker",
+				Title:             "Author-Book-EPUB",
+				CustomFormatScore: 150,
+				Seeders:           40,
+				Rejected:          true,
+				Rejections:        []string{"Not an upgrade"},
+				AuthorID:          2,
+				BookID:            5,
+			}},
+			WithError: nil,
+		},
+		{
+			Name:           "200 book only",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release") + "?bookId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[]`,
+			WithRequest:    &readarr.SearchRelease{BookID: 5},
+			WithResponse:   []*readarr.Release{},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release") + "?authorId=2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &readarr.SearchRelease{AuthorID: 2},
+			WithResponse:   []*readarr.Release(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*readarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrab(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.Grab("abc", 3)
+	require.NoError(t, err)
+	assert.Equal(t, &readarr.Grab{GUID: "abc", IndexerID: 3, Approved: true}, output)
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true,"downloadAllowed":true}`,
+			WithRequest:     &readarr.Release{GUID: "abc", IndexerID: 3, Title: "Author-Book-EPUB", BookID: 5},
+			WithResponse:    &readarr.Grab{GUID: "abc", IndexerID: 3, Approved: true, DownloadAllowed: true},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithRequest:     &readarr.Release{GUID: "abc", IndexerID: 3},
+			WithResponse:    (*readarr.Grab)(nil),
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GrabRelease(test.WithRequest.(*readarr.Release))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, 5, 6, 7, 0, 0, 0, time.UTC)
+	request := `{"title":"Author-Book-EPUB-GRP","downloadUrl":"http://rss/1.torrent","protocol":"torrent",` +
+		`"publishDate":"2024-05-06T07:00:00Z","size":1000,"indexer":"Scraper"}` + "\n"
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `[{"title":"Author-Book-EPUB-GRP","approved":false,"rejections":["Not an upgrade"]}]`,
+			WithResponse: []*readarr.Release{
+				{Title: "Author-Book-EPUB-GRP", Rejections: []string{"Not an upgrade"}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "single",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"title":"Author-Book-EPUB-GRP","approved":true}`,
+			WithResponse:    []*readarr.Release{{Title: "Author-Book-EPUB-GRP", Approved: true}},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithResponse:    []*readarr.Release(nil),
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.PushRelease(&readarr.PushRelease{
+				Title:       "Author-Book-EPUB-GRP",
+				DownloadURL: "http://rss/1.torrent",
+				Protocol:    starr.ProtocolTorrent,
+				PublishDate: published,
+				Size:        1000,
+				Indexer:     "Scraper",
+			})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
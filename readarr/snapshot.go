@@ -0,0 +1,222 @@
+package readarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BSFishy/starr"
+)
+
+// Snapshot is a copy of the settings in a Readarr instance. Create one with GetSnapshot or
+// ExportSnapshot, and copy it into another instance with ImportSnapshot.
+// Indexers, download clients, notifications, root folders and metadata profiles are not included.
+// This library has no Readarr custom formats or quality definitions, so those are not included either.
+type Snapshot struct {
+	starr.SnapshotHeader
+	Tags                 []*starr.Tag               `json:"tags"`
+	Naming               *Naming                    `json:"naming"`
+	MediaManagement      *MediaManagement           `json:"mediaManagement"`
+	IndexerConfig        *IndexerConfig             `json:"indexerConfig"`
+	DownloadClientConfig *DownloadClientConfig      `json:"downloadClientConfig"`
+	QualityProfiles      []*QualityProfile          `json:"qualityProfiles"`
+	RemotePathMappings   []*starr.RemotePathMapping `json:"remotePathMappings"`
+}
+
+// GetSnapshot returns a copy of the settings in Readarr.
+func (r *Readarr) GetSnapshot() (*Snapshot, error) {
+	return r.GetSnapshotContext(context.Background())
+}
+
+// GetSnapshotContext returns a copy of the settings in Readarr.
+func (r *Readarr) GetSnapshotContext(ctx context.Context) (*Snapshot, error) {
+	var (
+		snap = &Snapshot{SnapshotHeader: starr.NewSnapshotHeader(starr.Readarr)}
+		err  error
+	)
+
+	if snap.Tags, err = r.GetTagsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.Naming, err = r.GetNamingContext(ctx); err != nil {
+		return nil, err
+	} else if snap.MediaManagement, err = r.GetMediaManagementContext(ctx); err != nil {
+		return nil, err
+	} else if snap.IndexerConfig, err = r.GetIndexerConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.DownloadClientConfig, err = r.GetDownloadClientConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityProfiles, err = r.GetQualityProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.RemotePathMappings, err = r.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// ExportSnapshot writes a JSON snapshot of the settings in Readarr.
+func (r *Readarr) ExportSnapshot(output io.Writer) error {
+	return r.ExportSnapshotContext(context.Background(), output)
+}
+
+// ExportSnapshotContext writes a JSON snapshot of the settings in Readarr.
+func (r *Readarr) ExportSnapshotContext(ctx context.Context, output io.Writer) error {
+	snap, err := r.GetSnapshotContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snap); err != nil {
+		return fmt.Errorf("json.Marshal(snapshot): %w", err)
+	}
+
+	return nil
+}
+
+// ImportSnapshot reads a JSON snapshot, and applies it to Readarr with ApplySnapshot.
+func (r *Readarr) ImportSnapshot(input io.Reader) error {
+	return r.ImportSnapshotContext(context.Background(), input)
+}
+
+// ImportSnapshotContext reads a JSON snapshot, and applies it to Readarr with ApplySnapshot.
+func (r *Readarr) ImportSnapshotContext(ctx context.Context, input io.Reader) error {
+	var snap Snapshot
+	if err := json.NewDecoder(input).Decode(&snap); err != nil {
+		return fmt.Errorf("json.Unmarshal(snapshot): %w", err)
+	}
+
+	return r.ApplySnapshotContext(ctx, &snap)
+}
+
+// ApplySnapshot copies the settings in a snapshot into Readarr.
+func (r *Readarr) ApplySnapshot(snap *Snapshot) error {
+	return r.ApplySnapshotContext(context.Background(), snap)
+}
+
+// ApplySnapshotContext copies the settings in a snapshot into Readarr. Tags and quality profiles
+// are matched by name, and created when missing. IDs are remapped to the IDs in this instance.
+// Stops at the first error.
+func (r *Readarr) ApplySnapshotContext(ctx context.Context, snap *Snapshot) error {
+	if err := snap.Check(starr.Readarr); err != nil {
+		return err
+	}
+
+	existing, err := r.GetTagsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := starr.ImportTags(ctx, existing, snap.Tags, r.AddTagContext); err != nil {
+		return err
+	}
+
+	if err := r.applySnapshotConfig(ctx, snap); err != nil {
+		return err
+	}
+
+	if err := r.applySnapshotProfiles(ctx, snap.QualityProfiles); err != nil {
+		return err
+	}
+
+	return r.applySnapshotMappings(ctx, snap.RemotePathMappings)
+}
+
+// applySnapshotConfig updates the settings that exist once per instance.
+func (r *Readarr) applySnapshotConfig(ctx context.Context, snap *Snapshot) error {
+	if snap.Naming != nil {
+		if _, err := r.UpdateNamingContext(ctx, snap.Naming); err != nil {
+			return fmt.Errorf("importing naming: %w", err)
+		}
+	}
+
+	if snap.MediaManagement != nil {
+		if _, err := r.UpdateMediaManagementContext(ctx, snap.MediaManagement); err != nil {
+			return fmt.Errorf("importing media management: %w", err)
+		}
+	}
+
+	if snap.IndexerConfig != nil {
+		if _, err := r.UpdateIndexerConfigContext(ctx, snap.IndexerConfig); err != nil {
+			return fmt.Errorf("importing indexer config: %w", err)
+		}
+	}
+
+	if snap.DownloadClientConfig != nil {
+		if _, err := r.UpdateDownloadClientConfigContext(ctx, snap.DownloadClientConfig); err != nil {
+			return fmt.Errorf("importing download client config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotProfiles creates or updates quality profiles by name.
+// This library cannot manage Readarr custom formats, so format scores are not imported.
+// A profile that already exists keeps the scores it has.
+func (r *Readarr) applySnapshotProfiles(ctx context.Context, profiles []*QualityProfile) error {
+	existing, err := r.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]*QualityProfile, len(existing))
+	for _, profile := range existing {
+		names[strings.ToLower(profile.Name)] = profile
+	}
+
+	for _, profile := range profiles {
+		copied := *profile
+		copied.ID, copied.FormatItems = 0, nil
+
+		if found := names[strings.ToLower(profile.Name)]; found != nil {
+			copied.ID, copied.FormatItems = found.ID, found.FormatItems
+		}
+
+		if copied.ID == 0 {
+			_, err = r.AddQualityProfileContext(ctx, &copied)
+		} else {
+			_, err = r.UpdateQualityProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing quality profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotMappings creates or updates remote path mappings, matched by host and remote path.
+func (r *Readarr) applySnapshotMappings(ctx context.Context, mappings []*starr.RemotePathMapping) error {
+	existing, err := r.GetRemotePathMappingsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]int64, len(existing))
+	for _, mapping := range existing {
+		ids[mapping.Host+mapping.RemotePath] = mapping.ID
+	}
+
+	for _, mapping := range mappings {
+		copied := *mapping
+		copied.ID = ids[mapping.Host+mapping.RemotePath]
+
+		if copied.ID == 0 {
+			_, err = r.AddRemotePathMappingContext(ctx, &copied)
+		} else {
+			_, err = r.UpdateRemotePathMappingContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing remote path mapping '%s': %w", mapping.RemotePath, err)
+		}
+	}
+
+	return nil
+}
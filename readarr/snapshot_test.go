@@ -0,0 +1,96 @@
+package readarr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotBody returns the request body a client sends for input.
+func snapshotBody(t *testing.T, input interface{}) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(input))
+
+	return body.String()
+}
+
+func snapshotMock(method, uri, request, response string) *starrtest.MockData {
+	return &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, readarr.APIver, uri),
+		ExpectedMethod:  method,
+		ExpectedRequest: request,
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    response,
+	}
+}
+
+func snapshotGet(uri, response string) *starrtest.MockData {
+	return snapshotMock(http.MethodGet, uri, "", response)
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	source := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":1,"label":"epub"}]`),
+		snapshotGet("config/naming", `{"id":1,"renameBooks":true}`),
+		snapshotGet("config/mediaManagement", `{"id":1}`),
+		snapshotGet("config/indexer", `{"id":1}`),
+		snapshotGet("config/downloadClient", `{"id":1}`),
+		snapshotGet("qualityProfile", `[{"id":1,"name":"Ebook","cutoff":2,"formatItems":[{"format":8,"name":"Retail","score":50}]},`+
+			`{"id":2,"name":"Audio","formatItems":[{"format":8,"name":"Retail","score":10}]}]`),
+		snapshotGet("remotePathMapping", `[{"id":1,"host":"box","remotePath":"/dl/","localPath":"/data/"}]`),
+	)
+
+	var output bytes.Buffer
+
+	require.NoError(t, readarr.New(starr.New("mockAPIkey", source.URL, 0)).ExportSnapshot(&output))
+
+	var snap readarr.Snapshot
+	require.NoError(t, json.Unmarshal(output.Bytes(), &snap))
+	assert.Equal(t, starr.Readarr, snap.App)
+	assert.True(t, snap.Naming.RenameBooks)
+
+	target := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":3,"label":"EPUB"}]`),
+		snapshotMock(http.MethodPut, "config/naming", snapshotBody(t, snap.Naming), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/mediaManagement", snapshotBody(t, snap.MediaManagement), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/indexer/1", snapshotBody(t, snap.IndexerConfig), `{"id":1}`),
+		snapshotMock(http.MethodPut, "config/downloadClient/1", snapshotBody(t, snap.DownloadClientConfig), `{"id":1}`),
+		snapshotGet("qualityProfile", `[{"id":7,"name":"ebook","formatItems":[{"format":2,"name":"Retail","score":-20}]}]`),
+		snapshotMock(http.MethodPut, "qualityProfile/7", snapshotBody(t, &readarr.QualityProfile{
+			ID:          7,
+			Name:        "Ebook",
+			Cutoff:      2,
+			FormatItems: []*starr.FormatItem{{Format: 2, Name: "Retail", Score: -20}}, // kept from the target.
+		}), `{"id":7}`),
+		snapshotMock(http.MethodPost, "qualityProfile",
+			snapshotBody(t, &readarr.QualityProfile{Name: "Audio"}), `{"id":8}`), // new profiles get no format items.
+		snapshotGet("remotePathMapping", `[{"id":5,"host":"box","remotePath":"/dl/","localPath":"/old/"}]`),
+		snapshotMock(http.MethodPut, "remotePathMapping/5",
+			snapshotBody(t, &starr.RemotePathMapping{ID: 5, Host: "box", RemotePath: "/dl/", LocalPath: "/data/"}), `{"id":5}`),
+	)
+
+	client := readarr.New(starr.New("mockAPIkey", target.URL, 0))
+	require.NoError(t, client.ImportSnapshot(&output))
+}
+
+func TestImportSnapshotWrongApp(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t) // no requests may be made.
+	client := readarr.New(starr.New("mockAPIkey", server.URL, 0))
+	err := client.ImportSnapshot(strings.NewReader(`{"version":1,"app":"Whisparr"}`))
+	require.ErrorIs(t, err, starr.ErrSnapshotApp)
+}
@@ -0,0 +1,101 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, readarr.APIver, "wanted", "missing") +
+				"?includeAuthor=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=books.releaseDate",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"books.releaseDate","sortDirection":"ascending",` +
+				`"totalRecords":1,"records":[{"id":7,"title":"Book","authorId":2,"monitored":true}]}`,
+			WithRequest: &starr.PageReq{},
+			WithResponse: &readarr.Wanted{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "books.releaseDate",
+				SortDirection: "ascending",
+				TotalRecords:  1,
+				Records:       []*readarr.Book{{ID: 7, Title: "Book", AuthorID: 2, Monitored: true}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, readarr.APIver, "wanted", "missing") +
+				"?includeAuthor=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=books.releaseDate",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*readarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			params, _ := test.WithRequest.(*starr.PageReq)
+			output, err := client.GetWantedMissingPage(params, true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetWantedCutoff(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, readarr.APIver, "wanted", "cutoff") +
+			"?includeAuthor=true&monitored=false&page=1&pageSize=2&sortDirection=ascending&sortKey=books.releaseDate",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `{"page":1,"pageSize":2,"sortKey":"books.releaseDate","sortDirection":"ascending",` +
+			`"totalRecords":2,"records":[{"id":1},{"id":2}]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoff(2, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.TotalRecords)
+	assert.Len(t, output.Records, 2)
+}
+
+func TestGetWantedCutoffPageNil(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, readarr.APIver, "wanted", "cutoff") +
+			"?includeAuthor=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=books.releaseDate",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"page":1,"pageSize":10,"totalRecords":0,"records":[]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoffPage(nil, true)
+	require.NoError(t, err, "nil params must use the defaults")
+	assert.Empty(t, output.Records)
+}
@@ -0,0 +1,136 @@
+package reconcile
+
+import (
+	"context"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/lidarr"
+	"github.com/BSFishy/starr/prowlarr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/readarr"
+	"github.com/BSFishy/starr/sonarr"
+)
+
+/* Each app supports the kinds its package has methods for. Kinds an app does not support are ignored. */
+
+// Lidarr returns the resources that can be reconciled in Lidarr.
+// Lidarr has no delay profiles, and root folders cannot be created with this library.
+func Lidarr(app *lidarr.Lidarr) []*Resource {
+	return []*Resource{
+		resource(KindTags, app.GetTagsContext,
+			drop(app.AddTagContext), drop(app.UpdateTagContext), intID(app.DeleteTagContext)),
+		resource(KindCustomFormats, app.GetCustomFormatsContext,
+			drop(app.AddCustomFormatContext), drop(app.UpdateCustomFormatContext), app.DeleteCustomFormatContext),
+		resource(KindQualityProfiles, app.GetQualityProfilesContext,
+			drop(app.AddQualityProfileContext), drop(app.UpdateQualityProfileContext), app.DeleteQualityProfileContext),
+		resource(KindIndexers, app.GetIndexersContext,
+			drop(app.AddIndexerContext), force(app.UpdateIndexerContext), app.DeleteIndexerContext),
+		resource(KindDownloadClients, app.GetDownloadClientsContext,
+			drop(app.AddDownloadClientContext), force(app.UpdateDownloadClientContext), app.DeleteDownloadClientContext),
+		resource(KindNotifications, app.GetNotificationsContext,
+			drop(app.AddNotificationContext), drop(app.UpdateNotificationContext), app.DeleteNotificationContext),
+	}
+}
+
+// Prowlarr returns the resources that can be reconciled in Prowlarr.
+func Prowlarr(app *prowlarr.Prowlarr) []*Resource {
+	return []*Resource{
+		resource(KindTags, app.GetTagsContext,
+			drop(app.AddTagContext), drop(app.UpdateTagContext), intID(app.DeleteTagContext)),
+		resource(KindIndexers, app.GetIndexersContext,
+			drop(app.AddIndexerContext), force(app.UpdateIndexerContext), app.DeleteIndexerContext),
+		resource(KindDownloadClients, app.GetDownloadClientsContext,
+			drop(app.AddDownloadClientContext), force(app.UpdateDownloadClientContext), app.DeleteDownloadClientContext),
+		resource(KindNotifications, app.GetNotificationsContext,
+			drop(app.AddNotificationContext), drop(app.UpdateNotificationContext), app.DeleteNotificationContext),
+	}
+}
+
+// Radarr returns the resources that can be reconciled in Radarr. Root folders cannot be updated.
+func Radarr(app *radarr.Radarr) []*Resource {
+	return []*Resource{
+		resource(KindTags, app.GetTagsContext,
+			drop(app.AddTagContext), drop(app.UpdateTagContext), intID(app.DeleteTagContext)),
+		resource(KindCustomFormats, app.GetCustomFormatsContext,
+			drop(app.AddCustomFormatContext), drop(app.UpdateCustomFormatContext), app.DeleteCustomFormatContext),
+		resource(KindQualityProfiles, app.GetQualityProfilesContext,
+			drop(app.AddQualityProfileContext), drop(app.UpdateQualityProfileContext), app.DeleteQualityProfileContext),
+		resource(KindDelayProfiles, app.GetDelayProfilesContext,
+			drop(app.AddDelayProfileContext), drop(app.UpdateDelayProfileContext), app.DeleteDelayProfileContext),
+		resource(KindRootFolders, app.GetRootFoldersContext,
+			drop(app.AddRootFolderContext), nil, app.DeleteRootFolderContext),
+		resource(KindIndexers, app.GetIndexersContext,
+			drop(app.AddIndexerContext), force(app.UpdateIndexerContext), app.DeleteIndexerContext),
+		resource(KindDownloadClients, app.GetDownloadClientsContext,
+			drop(app.AddDownloadClientContext), force(app.UpdateDownloadClientContext), app.DeleteDownloadClientContext),
+		resource(KindNotifications, app.GetNotificationsContext,
+			drop(app.AddNotificationContext), drop(app.UpdateNotificationContext), app.DeleteNotificationContext),
+	}
+}
+
+// Readarr returns the resources that can be reconciled in Readarr.
+// Readarr has no custom formats or delay profiles, and root folders cannot be created with this library.
+func Readarr(app *readarr.Readarr) []*Resource {
+	return []*Resource{
+		resource(KindTags, app.GetTagsContext,
+			drop(app.AddTagContext), drop(app.UpdateTagContext), intID(app.DeleteTagContext)),
+		resource(KindQualityProfiles, app.GetQualityProfilesContext,
+			drop(app.AddQualityProfileContext), drop(app.UpdateQualityProfileContext), app.DeleteQualityProfileContext),
+		resource(KindIndexers, app.GetIndexersContext,
+			drop(app.AddIndexerContext), force(app.UpdateIndexerContext), app.DeleteIndexerContext),
+		resource(KindDownloadClients, app.GetDownloadClientsContext,
+			drop(app.AddDownloadClientContext), force(app.UpdateDownloadClientContext), app.DeleteDownloadClientContext),
+		resource(KindNotifications, app.GetNotificationsContext,
+			drop(app.AddNotificationContext), drop(app.UpdateNotificationContext), app.DeleteNotificationContext),
+	}
+}
+
+// Sonarr returns the resources that can be reconciled in Sonarr. Root folders cannot be updated.
+func Sonarr(app *sonarr.Sonarr) []*Resource {
+	return []*Resource{
+		resource(KindTags, app.GetTagsContext,
+			drop(app.AddTagContext), drop(app.UpdateTagContext), intID(app.DeleteTagContext)),
+		resource(KindCustomFormats, app.GetCustomFormatsContext,
+			drop(app.AddCustomFormatContext), drop(app.UpdateCustomFormatContext), app.DeleteCustomFormatContext),
+		resource(KindQualityProfiles, app.GetQualityProfilesContext,
+			drop(app.AddQualityProfileContext), drop(app.UpdateQualityProfileContext), app.DeleteQualityProfileContext),
+		resource(KindDelayProfiles, app.GetDelayProfilesContext,
+			drop(app.AddDelayProfileContext), drop(app.UpdateDelayProfileContext), app.DeleteDelayProfileContext),
+		resource(KindRootFolders, app.GetRootFoldersContext,
+			drop(app.AddRootFolderContext), nil, app.DeleteRootFolderContext),
+		resource(KindIndexers, app.GetIndexersContext,
+			drop(app.AddIndexerContext), force(app.UpdateIndexerContext), app.DeleteIndexerContext),
+		resource(KindDownloadClients, app.GetDownloadClientsContext,
+			drop(app.AddDownloadClientContext), force(app.UpdateDownloadClientContext), app.DeleteDownloadClientContext),
+		resource(KindNotifications, app.GetNotificationsContext,
+			drop(app.AddNotificationContext), drop(app.UpdateNotificationContext), app.DeleteNotificationContext),
+	}
+}
+
+// drop discards the output of an add or update method.
+func drop[In, Out any](method func(context.Context, In) (Out, error)) func(context.Context, In) error {
+	return func(ctx context.Context, input In) error {
+		_, err := method(ctx, input)
+		return err
+	}
+}
+
+// force discards the output of an update method that can force the update.
+// Updates are only forced when the app rejects them with nothing but warnings.
+func force[In, Out any](method func(context.Context, In, bool) (Out, error)) func(context.Context, In) error {
+	return func(ctx context.Context, input In) error {
+		_, err := method(ctx, input, false)
+		if starr.IsWarning(err) {
+			_, err = method(ctx, input, true)
+		}
+
+		return err
+	}
+}
+
+// intID adapts the tag delete methods, which take an int.
+func intID(method func(context.Context, int) error) func(context.Context, int64) error {
+	return func(ctx context.Context, id int64) error {
+		return method(ctx, int(id))
+	}
+}
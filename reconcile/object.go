@@ -0,0 +1,300 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// byName lists the members whose lists are matched by the name of each item, instead of replaced.
+// An app returns every field and every format score, but a State only needs the ones it sets.
+var byName = map[string]bool{"fields": true, "formatItems": true}
+
+// resource creates a Resource from an app's typed methods. In is the type the app accepts
+// and Out is the type it returns. A nil update means the app cannot update this kind.
+func resource[In, Out any](
+	kind Kind,
+	list func(context.Context) ([]Out, error),
+	add func(context.Context, In) error,
+	update func(context.Context, In) error,
+	remove func(context.Context, int64) error,
+) *Resource {
+	res := &Resource{
+		Kind: kind,
+		List: func(ctx context.Context) ([]Object, error) {
+			items, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			output := make([]Object, len(items))
+
+			for idx, item := range items {
+				if err := convert(item, &output[idx]); err != nil {
+					return nil, err
+				}
+			}
+
+			return output, nil
+		},
+		Add: func(ctx context.Context, obj Object) error {
+			var input In
+			if err := convert(obj, &input); err != nil {
+				return err
+			}
+
+			return add(ctx, input)
+		},
+		Delete: remove,
+	}
+
+	if update != nil {
+		res.Update = func(ctx context.Context, obj Object) error {
+			var input In
+			if err := convert(obj, &input); err != nil {
+				return err
+			}
+
+			return update(ctx, input)
+		}
+	}
+
+	return res
+}
+
+// convert copies one type into another through JSON.
+func convert(input, output interface{}) error {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("encoding resource: %w", err)
+	}
+
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("decoding resource: %w", err)
+	}
+
+	return nil
+}
+
+// key returns the value that identifies a resource of a kind.
+func key(kind Kind, obj Object) string {
+	switch kind { //nolint:exhaustive
+	case KindTags:
+		return strings.ToLower(fmt.Sprint(obj["label"]))
+	case KindRootFolders:
+		return strings.TrimRight(fmt.Sprint(obj["path"]), `/\`)
+	case KindDelayProfiles:
+		tags, _ := obj["tags"].([]interface{})
+		ids := make([]string, len(tags))
+
+		for idx, tag := range tags {
+			ids[idx] = fmt.Sprint(tag)
+		}
+
+		sort.Strings(ids)
+
+		return strings.Join(ids, ",")
+	default:
+		return strings.ToLower(fmt.Sprint(obj["name"]))
+	}
+}
+
+// id returns the ID of a resource.
+func id(obj Object) int64 {
+	val, _ := obj["id"].(float64)
+	return int64(val)
+}
+
+// diff returns the top level members of want that do not match have, sorted.
+func diff(have, want Object) []string {
+	fields := []string{}
+
+	for name, val := range want {
+		if !contains(name, have[name], val) {
+			fields = append(fields, name)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// contains returns true if every value in want is also in have. Missing values in have
+// are treated as zero values, because the app types omit empty members.
+func contains(name string, have, want interface{}) bool {
+	switch want := want.(type) {
+	case Object:
+		have, _ := have.(Object)
+
+		for k, v := range want {
+			if !contains(k, have[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		have, _ := have.([]interface{})
+		if byName[name] {
+			return containsByName(have, want)
+		}
+
+		return containsList(have, want)
+	case nil:
+		return zero(have)
+	default:
+		if have == nil {
+			return zero(want)
+		}
+
+		return have == want
+	}
+}
+
+// containsByName returns true if every item in want matches the item with the same name in have.
+func containsByName(have, want []interface{}) bool {
+	for _, item := range want {
+		obj, _ := item.(Object)
+		if found := find(have, obj); found == nil || !contains("", found, obj) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsList compares lists item by item. Lists of plain values, like tags, are compared in any order.
+func containsList(have, want []interface{}) bool {
+	if len(have) != len(want) {
+		return false
+	} else if len(want) == 0 {
+		return true
+	}
+
+	if _, ok := want[0].(Object); !ok {
+		have, want = sortedValues(have), sortedValues(want)
+	}
+
+	for idx := range want {
+		if !contains("", have[idx], want[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedValues(list []interface{}) []interface{} {
+	output := append([]interface{}{}, list...)
+	sort.Slice(output, func(i, j int) bool { return fmt.Sprint(output[i]) < fmt.Sprint(output[j]) })
+
+	return output
+}
+
+func zero(val interface{}) bool {
+	switch val := val.(type) {
+	case nil:
+		return true
+	case bool:
+		return !val
+	case float64:
+		return val == 0
+	case string:
+		return val == ""
+	case []interface{}:
+		return len(val) == 0
+	case Object:
+		return len(val) == 0
+	default:
+		return false
+	}
+}
+
+// find returns the item in list with the same name as obj.
+func find(list []interface{}, obj Object) Object {
+	if idx := index(list, obj); idx >= 0 {
+		return list[idx].(Object) //nolint:forcetypeassert
+	}
+
+	return nil
+}
+
+// merge returns a copy of have with every value from want applied to it.
+func merge(have, want interface{}) interface{} {
+	return mergeValue("", have, want)
+}
+
+func mergeValue(name string, have, want interface{}) interface{} {
+	switch want := want.(type) {
+	case Object:
+		output, ok := copyValue(have).(Object)
+		if !ok {
+			output = Object{}
+		}
+
+		for k, v := range want {
+			output[k] = mergeValue(k, output[k], v)
+		}
+
+		return output
+	case []interface{}:
+		list, ok := have.([]interface{})
+		if !ok || !byName[name] {
+			return copyValue(want)
+		}
+
+		output := copyValue(list).([]interface{}) //nolint:forcetypeassert
+
+		for _, item := range want {
+			obj, _ := item.(Object)
+
+			if idx := index(output, obj); idx >= 0 {
+				output[idx] = mergeValue("", output[idx], obj)
+			} else {
+				output = append(output, copyValue(item))
+			}
+		}
+
+		return output
+	default:
+		return want
+	}
+}
+
+// index returns the position of the item in list with the same name as obj, or -1.
+func index(list []interface{}, obj Object) int {
+	for idx, item := range list {
+		if found, ok := item.(Object); ok && obj != nil && found["name"] == obj["name"] {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// copyValue returns a deep copy of a decoded JSON value. Maps are copied into an Object.
+func copyValue(val interface{}) interface{} {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		return copyValue(Object(val))
+	case Object:
+		output := make(Object, len(val))
+		for k, v := range val {
+			output[k] = copyValue(v)
+		}
+
+		return output
+	case []interface{}:
+		output := make([]interface{}, len(val))
+		for idx, v := range val {
+			output[idx] = copyValue(v)
+		}
+
+		return output
+	default:
+		return val
+	}
+}
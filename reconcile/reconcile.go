@@ -0,0 +1,463 @@
+// Package reconcile converges the configuration of a Starr app to a desired state.
+// Describe tags, custom formats, quality profiles, delay profiles, root folders,
+// indexers, download clients and notifications in a State, usually read from a JSON
+// file, then create a Plan for an instance and Apply it. Resources in a State use the
+// same JSON the apps use in their API, so an existing resource can be copied in as is.
+//
+// Resources are matched by name; tags by label, root folders by path, and delay profiles
+// by their tags. A State may refer to tags by label, ie. "tags": ["4k"], and quality
+// profile formatItems may refer to custom formats by name, so one State works on every
+// instance regardless of the IDs each one assigned.
+//
+//	plan, err := reconcile.NewPlan(ctx, reconcile.Radarr(client), state, &reconcile.Options{Prune: true})
+//	fmt.Print(plan) // Dry run.
+//	err = plan.Apply(ctx)
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrApplyFailed is returned by Apply when one or more changes fail. Check each Change's Err.
+var ErrApplyFailed = errors.New("one or more changes failed")
+
+// Kind is a type of resource that can be reconciled.
+type Kind string
+
+// These are the kinds of resources this package reconciles, in the order they are created and updated.
+// Deletes run last, in reverse order.
+// Tags and custom formats are applied first, so other resources can refer to them.
+const (
+	KindTags            Kind = "tags"
+	KindCustomFormats   Kind = "customFormats"
+	KindQualityProfiles Kind = "qualityProfiles"
+	KindDelayProfiles   Kind = "delayProfiles"
+	KindRootFolders     Kind = "rootFolders"
+	KindIndexers        Kind = "indexers"
+	KindDownloadClients Kind = "downloadClients"
+	KindNotifications   Kind = "notifications"
+)
+
+// kinds is the order resources are planned and applied in.
+var kinds = []Kind{
+	KindTags, KindCustomFormats, KindQualityProfiles, KindDelayProfiles,
+	KindRootFolders, KindIndexers, KindDownloadClients, KindNotifications,
+}
+
+// Action is what a Change does.
+type Action string
+
+// These are the possible actions in a Plan.
+const (
+	ActionNone   Action = "none"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Object is a resource as decoded from JSON.
+type Object map[string]interface{}
+
+// State is the desired configuration of an instance. A nil list is not managed;
+// an empty list is managed and, with Options.Prune, removes every resource of that kind.
+// Resources are the same JSON objects the app's API uses.
+type State struct {
+	Tags            []string `json:"tags,omitempty"`
+	CustomFormats   []Object `json:"customFormats,omitempty"`
+	QualityProfiles []Object `json:"qualityProfiles,omitempty"`
+	DelayProfiles   []Object `json:"delayProfiles,omitempty"`
+	RootFolders     []Object `json:"rootFolders,omitempty"`
+	Indexers        []Object `json:"indexers,omitempty"`
+	DownloadClients []Object `json:"downloadClients,omitempty"`
+	Notifications   []Object `json:"notifications,omitempty"`
+}
+
+// Options control how a Plan is created.
+type Options struct {
+	// Prune deletes resources that are not in the State. Only kinds with a non-nil list in the State are pruned.
+	Prune bool
+}
+
+// Resource connects a Kind to the methods that manage it in one app.
+// Update is nil for kinds the app cannot update; those are only created or deleted.
+type Resource struct {
+	Kind   Kind
+	List   func(ctx context.Context) ([]Object, error)
+	Add    func(ctx context.Context, obj Object) error
+	Update func(ctx context.Context, obj Object) error
+	Delete func(ctx context.Context, id int64) error
+}
+
+// Change is one step in a Plan. After Apply, Applied and Err hold the result.
+type Change struct {
+	Kind   Kind
+	Key    string
+	Action Action
+	// Fields lists the top level members that differ, for updates.
+	Fields  []string
+	Applied bool
+	Err     error
+	want    Object
+	have    Object
+}
+
+// Plan is the list of changes that converge an instance to a State.
+type Plan struct {
+	Changes   []*Change
+	resources []*Resource
+	refs      *references
+}
+
+// NewPlan compares the State to the resources in an instance and returns the changes to make.
+// Nothing is changed; print the plan for a dry run, and call Apply to make the changes.
+func NewPlan(ctx context.Context, resources []*Resource, state *State, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := &Plan{resources: ordered(resources), refs: &references{}}
+	wants, prunable := state.wants()
+
+	for _, res := range plan.resources {
+		want, managed := wants[res.Kind]
+		// Tags and custom formats are always listed, because other resources may refer to them.
+		if !managed && res.Kind != KindTags && res.Kind != KindCustomFormats {
+			continue
+		}
+
+		have, err := res.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", res.Kind, err)
+		}
+
+		if plan.refs.load(res.Kind, have); !managed {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, plan.compare(res, want, have, opts.Prune && prunable[res.Kind])...)
+	}
+
+	return plan, nil
+}
+
+// Apply makes the changes in the plan. Creates and updates run first, in kind order, then
+// deletes run in reverse kind order, so nothing is deleted while another resource refers to it.
+// It continues after a failed change, and returns ErrApplyFailed if any change failed.
+// Each Change records its own result.
+func (p *Plan) Apply(ctx context.Context) error {
+	failed := 0
+
+	for _, res := range p.resources {
+		applied := p.applyKind(ctx, res, false, &failed)
+
+		if applied && (res.Kind == KindTags || res.Kind == KindCustomFormats) {
+			have, err := res.List(ctx)
+			if err != nil {
+				return fmt.Errorf("listing %s: %w", res.Kind, err)
+			}
+
+			p.refs.load(res.Kind, have)
+		}
+	}
+
+	for idx := len(p.resources) - 1; idx >= 0; idx-- {
+		p.applyKind(ctx, p.resources[idx], true, &failed)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d", ErrApplyFailed, failed, len(p.Pending()))
+	}
+
+	return nil
+}
+
+// applyKind makes the pending changes for one resource; only deletes, or everything but deletes.
+func (p *Plan) applyKind(ctx context.Context, res *Resource, deletes bool, failed *int) bool {
+	applied := false
+
+	for _, change := range p.Changes {
+		if change.Kind != res.Kind || change.Action == ActionNone || change.Applied ||
+			(change.Action == ActionDelete) != deletes {
+			continue
+		}
+
+		// References may point to resources created earlier in this Apply.
+		change.Err = p.apply(ctx, res, change)
+		change.Applied = change.Err == nil
+		applied = true
+
+		if change.Err != nil {
+			*failed++
+		}
+	}
+
+	return applied
+}
+
+func (p *Plan) apply(ctx context.Context, res *Resource, change *Change) error {
+	switch change.Action {
+	case ActionCreate:
+		return wrap(change, res.Add(ctx, p.refs.resolve(change.want)))
+	case ActionUpdate:
+		return wrap(change, res.Update(ctx, merge(change.have, p.refs.resolve(change.want)).(Object)))
+	case ActionDelete:
+		return wrap(change, res.Delete(ctx, id(change.have)))
+	case ActionNone:
+	}
+
+	return nil
+}
+
+func wrap(change *Change, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s %s '%s': %w", change.Action, change.Kind, change.Key, err)
+	}
+
+	return nil
+}
+
+// ordered returns the resources sorted in the order their kinds must be applied.
+func ordered(resources []*Resource) []*Resource {
+	output := make([]*Resource, 0, len(resources))
+
+	for _, kind := range kinds {
+		for _, res := range resources {
+			if res.Kind == kind {
+				output = append(output, res)
+			}
+		}
+	}
+
+	return output
+}
+
+// Pending returns the changes that create, update or delete something.
+func (p *Plan) Pending() []*Change {
+	changes := []*Change{}
+
+	for _, change := range p.Changes {
+		if change.Action != ActionNone {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// String returns the pending changes, one per line, and their results after Apply.
+func (p *Plan) String() string {
+	var buf strings.Builder
+
+	for _, change := range p.Pending() {
+		buf.WriteString(change.String() + "\n")
+	}
+
+	return buf.String()
+}
+
+// String returns a single line that describes the change.
+func (c *Change) String() string {
+	msg := fmt.Sprintf("%s %s '%s'", c.Action, c.Kind, c.Key)
+	if len(c.Fields) > 0 {
+		msg += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+
+	switch {
+	case c.Err != nil:
+		return msg + ": " + c.Err.Error()
+	case c.Applied:
+		return msg + ": done"
+	default:
+		return msg
+	}
+}
+
+// compare matches wanted resources to existing ones and returns a change for each.
+func (p *Plan) compare(res *Resource, want, have []Object, prune bool) []*Change {
+	existing := make(map[string]Object, len(have))
+	for _, obj := range have {
+		existing[key(res.Kind, obj)] = obj
+	}
+
+	changes := []*Change{}
+	seen := make(map[string]bool, len(want))
+
+	for _, obj := range want {
+		resolved := p.refs.resolve(obj)
+		change := &Change{Kind: res.Kind, Key: key(res.Kind, resolved), Action: ActionNone, want: obj}
+		seen[change.Key] = true
+
+		if change.have = existing[change.Key]; change.have == nil {
+			change.Action = ActionCreate
+		} else if change.Fields = diff(change.have, resolved); len(change.Fields) > 0 && res.Update != nil {
+			change.Action = ActionUpdate
+		}
+
+		changes = append(changes, change)
+	}
+
+	if !prune {
+		return changes
+	}
+
+	for _, obj := range have {
+		// An empty key is the default delay profile, which cannot be deleted.
+		if k := key(res.Kind, obj); k != "" && !seen[k] {
+			changes = append(changes, &Change{Kind: res.Kind, Key: k, Action: ActionDelete, have: obj})
+		}
+	}
+
+	return changes
+}
+
+// wants returns the managed resources for each kind, and whether each kind may be pruned.
+// Tags referenced by label in other resources are added, but only declared tags make them prunable.
+func (s *State) wants() (map[Kind][]Object, map[Kind]bool) {
+	wants, prunable := map[Kind][]Object{}, map[Kind]bool{}
+	labels := map[string]bool{}
+
+	for kind, list := range map[Kind][]Object{
+		KindCustomFormats:   s.CustomFormats,
+		KindQualityProfiles: s.QualityProfiles,
+		KindDelayProfiles:   s.DelayProfiles,
+		KindRootFolders:     s.RootFolders,
+		KindIndexers:        s.Indexers,
+		KindDownloadClients: s.DownloadClients,
+		KindNotifications:   s.Notifications,
+	} {
+		if list == nil {
+			continue
+		}
+
+		wants[kind], prunable[kind] = list, true
+
+		for _, obj := range list {
+			tagLabels(obj, labels)
+		}
+	}
+
+	for _, label := range s.Tags {
+		labels[label] = true
+	}
+
+	if s.Tags == nil && len(labels) == 0 {
+		return wants, prunable
+	}
+
+	sorted := make([]string, 0, len(labels))
+	for label := range labels {
+		sorted = append(sorted, label)
+	}
+
+	sort.Strings(sorted)
+
+	wants[KindTags], prunable[KindTags] = []Object{}, s.Tags != nil
+	for _, label := range sorted {
+		wants[KindTags] = append(wants[KindTags], Object{"label": label})
+	}
+
+	return wants, prunable
+}
+
+// references maps tag labels and custom format names to their IDs in an instance.
+type references struct {
+	tags    map[string]float64
+	formats map[string]float64
+}
+
+func (r *references) load(kind Kind, have []Object) {
+	ids := make(map[string]float64, len(have))
+
+	for _, obj := range have {
+		if id, ok := obj["id"].(float64); ok {
+			ids[key(kind, obj)] = id
+		}
+	}
+
+	switch kind { //nolint:exhaustive
+	case KindTags:
+		r.tags = ids
+	case KindCustomFormats:
+		r.formats = ids
+	}
+}
+
+// resolve returns a copy of obj with tag labels and format names replaced by IDs, and without its own ID.
+// Unknown labels become 0; they are resolved again during Apply, after tags are created.
+func (r *references) resolve(obj Object) Object {
+	output := r.walk("", copyValue(obj)).(Object) //nolint:forcetypeassert
+	delete(output, "id")
+
+	return output
+}
+
+func (r *references) walk(name string, val interface{}) interface{} {
+	switch val := val.(type) {
+	case Object:
+		for k, v := range val {
+			val[k] = r.walk(k, v)
+		}
+
+		return val
+	case []interface{}:
+		for idx, v := range val {
+			switch {
+			case name == "tags":
+				if label, ok := v.(string); ok {
+					val[idx] = r.tags[strings.ToLower(label)]
+				}
+			case name == "formatItems":
+				if item, ok := v.(Object); ok && item["name"] != nil {
+					item["format"] = r.formats[strings.ToLower(fmt.Sprint(item["name"]))]
+				}
+			default:
+				val[idx] = r.walk("", v)
+			}
+		}
+
+		return val
+	default:
+		return val
+	}
+}
+
+// tagLabels adds every tag label referenced in obj to labels.
+func tagLabels(val interface{}, labels map[string]bool) {
+	switch val := val.(type) {
+	case Object:
+		for k, v := range val {
+			if list, ok := v.([]interface{}); ok && k == "tags" {
+				for _, tag := range list {
+					if label, ok := tag.(string); ok {
+						labels[label] = true
+					}
+				}
+
+				continue
+			}
+
+			tagLabels(v, labels)
+		}
+	case []interface{}:
+		for _, v := range val {
+			tagLabels(v, labels)
+		}
+	}
+}
+
+// UnmarshalJSON makes nested objects an Object, so they can be compared and merged.
+func (o *Object) UnmarshalJSON(data []byte) error {
+	var val map[string]interface{}
+	if err := json.Unmarshal(data, &val); err != nil {
+		return fmt.Errorf("decoding resource: %w", err)
+	}
+
+	*o = copyValue(val).(Object) //nolint:forcetypeassert
+
+	return nil
+}
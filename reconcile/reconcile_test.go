@@ -0,0 +1,151 @@
+package reconcile_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/BSFishy/starr/reconcile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errInUse = errors.New("409: in use")
+
+// memory is an in-memory list of resources of one kind.
+type memory struct {
+	items  []reconcile.Object
+	nextID float64
+	calls  []string
+	// inUse rejects deletes like the apps do, when another resource refers to the item.
+	inUse func(id float64) bool
+}
+
+func (m *memory) resource(kind reconcile.Kind) *reconcile.Resource {
+	return &reconcile.Resource{
+		Kind: kind,
+		List: func(_ context.Context) ([]reconcile.Object, error) {
+			return m.items, nil
+		},
+		Add: func(_ context.Context, obj reconcile.Object) error {
+			m.nextID++
+			obj["id"] = m.nextID
+			m.items = append(m.items, obj)
+			m.calls = append(m.calls, "add")
+
+			return nil
+		},
+		Update: func(_ context.Context, obj reconcile.Object) error {
+			for idx, item := range m.items {
+				if item["id"] == obj["id"] {
+					m.items[idx] = obj
+				}
+			}
+
+			m.calls = append(m.calls, "update")
+
+			return nil
+		},
+		Delete: func(_ context.Context, id int64) error {
+			if m.inUse != nil && m.inUse(float64(id)) {
+				return errInUse
+			}
+
+			for idx, item := range m.items {
+				if item["id"] == float64(id) {
+					m.items = append(m.items[:idx], m.items[idx+1:]...)
+					break
+				}
+			}
+
+			m.calls = append(m.calls, "delete")
+
+			return nil
+		},
+	}
+}
+
+func decode(t *testing.T, data string) []reconcile.Object {
+	t.Helper()
+
+	var output []reconcile.Object
+	require.NoError(t, json.Unmarshal([]byte(data), &output))
+
+	return output
+}
+
+// tagged returns true if any item in the list has the tag.
+func tagged(list *memory) func(float64) bool {
+	return func(id float64) bool {
+		for _, item := range list.items {
+			tags, _ := item["tags"].([]interface{})
+			for _, tag := range tags {
+				if tag == id {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+}
+
+func TestPlanApply(t *testing.T) {
+	t.Parallel()
+
+	tags := &memory{items: decode(t, `[{"id":1,"label":"old"}]`), nextID: 1}
+	formats := &memory{items: decode(t, `[{"id":7,"name":"x265","includeCustomFormatWhenRenaming":false}]`), nextID: 7}
+	profiles := &memory{items: decode(t, `[{"id":3,"name":"HD","cutoff":4,"minFormatScore":0,"formatItems":[
+		{"format":7,"name":"x265","score":0},{"format":9,"name":"Other","score":5}]}]`), nextID: 3}
+	clients := &memory{items: decode(t, `[{"id":2,"name":"Deluge","enable":true,"tags":[1],"fields":[
+		{"name":"host","value":"deluge","label":"Host"},{"name":"port","value":8112,"label":"Port"}]}]`), nextID: 2}
+	tags.inUse = tagged(clients) // the old tag may only be deleted after the client stops using it.
+
+	var state reconcile.State
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"tags": ["4k"],
+		"customFormats": [{"name":"x265"},{"id":99,"name":"HDR","specifications":[]}],
+		"qualityProfiles": [{"name":"HD","minFormatScore":10,"formatItems":[{"name":"HDR","score":100}]}],
+		"downloadClients": [{"name":"Deluge","enable":true,"tags":["4k"],"fields":[{"name":"port","value":8112}]}]
+	}`), &state))
+
+	resources := []*reconcile.Resource{
+		clients.resource(reconcile.KindDownloadClients), // out of order on purpose.
+		tags.resource(reconcile.KindTags),
+		formats.resource(reconcile.KindCustomFormats),
+		profiles.resource(reconcile.KindQualityProfiles),
+	}
+
+	plan, err := reconcile.NewPlan(context.Background(), resources, &state, &reconcile.Options{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, "create tags '4k'\n"+
+		"delete tags 'old'\n"+
+		"create customFormats 'hdr'\n"+
+		"update qualityProfiles 'hd' (formatItems, minFormatScore)\n"+
+		"update downloadClients 'deluge' (tags)\n", plan.String(), "the plan must list every change in order")
+	assert.Empty(t, tags.calls, "planning must not change anything")
+
+	require.NoError(t, plan.Apply(context.Background()))
+
+	for _, change := range plan.Pending() {
+		assert.True(t, change.Applied, change.String())
+	}
+
+	assert.Equal(t, float64(2), tags.items[0]["id"], "the new tag must be created")
+	assert.Len(t, tags.items, 1, "the old tag must be pruned")
+	assert.Equal(t, []interface{}{float64(2)}, clients.items[0]["tags"], "tag labels must be resolved after they are created")
+	assert.Equal(t, "deluge", clients.items[0]["fields"].([]interface{})[0].(reconcile.Object)["value"],
+		"fields that are not in the state must be kept")
+	assert.Equal(t, float64(8), formats.items[1]["id"], "the state's own ID must not be sent")
+
+	items := profiles.items[0]["formatItems"].([]interface{})
+	require.Len(t, items, 3, "format scores that are not in the state must be kept")
+	assert.Equal(t, reconcile.Object{"name": "HDR", "score": float64(100), "format": float64(8)}, items[2],
+		"format names must be resolved to the new format's ID")
+
+	// Running it again does nothing.
+	plan, err = reconcile.NewPlan(context.Background(), resources, &state, &reconcile.Options{Prune: true})
+	require.NoError(t, err)
+	assert.Empty(t, plan.Pending(), plan.String())
+}
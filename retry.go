@@ -0,0 +1,147 @@
+package starr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+/* This file contains the retry logic used by every request made through a starr.Config. */
+
+// Defaults for RetryPolicy. Used when the respective policy value is zero.
+const (
+	DefaultRetryMinWait = 500 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests are retried. Attach one to a starr.Config
+// to have every app package retry requests that fail with a 429, a 5xx, or a
+// broken connection. A nil policy, or one with MaxAttempts below 2, disables retries.
+type RetryPolicy struct {
+	// Total number of attempts, including the first. 0 or 1 means no retries.
+	MaxAttempts int `json:"maxAttempts" toml:"max_attempts" xml:"max_attempts" yaml:"maxAttempts"`
+	// Initial backoff. Each retry doubles it. Default is DefaultRetryMinWait.
+	MinWait time.Duration `json:"minWait" toml:"min_wait" xml:"min_wait" yaml:"minWait"`
+	// Ceiling for any single backoff, including a server provided Retry-After.
+	// Default is DefaultRetryMaxWait.
+	MaxWait time.Duration `json:"maxWait" toml:"max_wait" xml:"max_wait" yaml:"maxWait"`
+	// Only idempotent methods (GET, HEAD, PUT, DELETE, OPTIONS) are retried by default.
+	// Set this true to also retry POST requests. Those may create duplicate items.
+	AllMethods bool `json:"allMethods" toml:"all_methods" xml:"all_methods" yaml:"allMethods"`
+}
+
+// attempts returns the number of times a request with this method may be attempted.
+func (p *RetryPolicy) attempts(method string) int {
+	if p == nil || p.MaxAttempts < 2 { //nolint:mnd
+		return 1
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return p.MaxAttempts
+	default:
+		if p.AllMethods {
+			return p.MaxAttempts
+		}
+
+		return 1
+	}
+}
+
+// retryable returns true if the request error is worth trying again.
+func (p *RetryPolicy) retryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var (
+		codeErr *ReqError
+		netErr  net.Error
+		opErr   *net.OpError
+	)
+
+	if !errors.As(err, &codeErr) {
+		return errors.As(err, &opErr) || // connection refused or reset.
+			errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+			(errors.As(err, &netErr) && netErr.Timeout())
+	}
+
+	switch codeErr.Code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait returns how long to sleep before the next attempt. attempt starts at 1.
+// A Retry-After header is honored, otherwise exponential backoff with full jitter is used.
+func (p *RetryPolicy) wait(attempt int, err error) time.Duration {
+	minWait, maxWait := p.MinWait, p.MaxWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if after, ok := retryAfter(err); ok {
+		if after > maxWait {
+			return maxWait
+		}
+
+		return after
+	}
+
+	backoff := maxWait
+	if shift := attempt - 1; shift < 32 && minWait<<shift > 0 && minWait<<shift < maxWait { //nolint:mnd // overflow.
+		backoff = minWait << shift
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff))) + 1 //nolint:gosec // jitter does not need crypto.
+}
+
+// retryAfter parses the Retry-After header from a ReqError. It may be seconds or an HTTP date.
+func retryAfter(err error) (time.Duration, bool) {
+	var codeErr *ReqError
+	if !errors.As(err, &codeErr) || codeErr.Header == nil || codeErr.Get("Retry-After") == "" {
+		return 0, false
+	}
+
+	value := codeErr.Get("Retry-After")
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if until := time.Until(date); until > 0 {
+			return until, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the duration, or until the context is canceled.
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to retry request: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
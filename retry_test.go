@@ -0,0 +1,95 @@
+package starr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer returns 503 until it has been called failures times, then 200.
+func flakyServer(t *testing.T, failures int32, calls *int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if body, _ := io.ReadAll(req.Body); req.Method == http.MethodPut {
+			assert.Equal(t, `{"id":1}`, string(body), "body must be sent on every attempt")
+		}
+
+		if atomic.AddInt32(calls, 1) <= failures {
+			writer.Header().Set("Retry-After", "0")
+			writer.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{"id":1}`))
+	}))
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 2, &calls)
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+	config.Retry = &starr.RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond}
+
+	var output struct {
+		ID int `json:"id"`
+	}
+
+	req := starr.Request{URI: "/thing", Body: strings.NewReader(`{"id":1}`)}
+	require.NoError(t, config.PutInto(context.Background(), req, &output))
+	assert.Equal(t, 1, output.ID)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls), "the request must be attempted 3 times")
+}
+
+func TestRetryPolicyExhausted(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 5, &calls)
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+	config.Retry = &starr.RetryPolicy{MaxAttempts: 2, MinWait: time.Millisecond}
+
+	err := config.DeleteAny(context.Background(), starr.Request{URI: "/thing"})
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusServiceUnavailable})
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls), "the request must be attempted 2 times")
+}
+
+func TestRetryPolicyPOST(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 1, &calls)
+	defer server.Close()
+
+	config := starr.New("key", server.URL, 0)
+	config.Retry = &starr.RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond}
+
+	var output interface{}
+
+	err := config.PostInto(context.Background(), starr.Request{URI: "/thing"}, &output)
+	require.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls), "POST must not be retried by default")
+
+	config.Retry.AllMethods = true
+	require.NoError(t, config.PostInto(context.Background(), starr.Request{URI: "/thing"}, &output))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls), "POST must be retried with AllMethods")
+}
@@ -150,6 +150,47 @@ type BackupFile struct {
 	Size int64     `json:"size"`
 }
 
+// HealthCheck comes from the /health path in all apps.
+// Each item represents one problem the app found with itself.
+type HealthCheck struct {
+	ID      int64  `json:"id,omitempty"`
+	Source  string `json:"source"`  // Name of the check that failed, ie. IndexerStatusCheck.
+	Type    string `json:"type"`    // ok, notice, warning or error.
+	Message string `json:"message"` // Description of the problem.
+	WikiURL string `json:"wikiUrl"` // Link to the wiki page about the problem.
+}
+
+// Logs is returned from the paged /log path in all apps.
+type Logs struct {
+	Page          int          `json:"page"`
+	PageSize      int          `json:"pageSize"`
+	SortKey       string       `json:"sortKey"`
+	SortDirection string       `json:"sortDirection"`
+	TotalRecords  int          `json:"totalRecords"`
+	Records       []*LogRecord `json:"records"`
+}
+
+// LogRecord is a single application log entry, part of Logs.
+type LogRecord struct {
+	ID            int64     `json:"id"`
+	Time          time.Time `json:"time"`
+	Level         string    `json:"level"`
+	Logger        string    `json:"logger"`
+	Message       string    `json:"message"`
+	Method        string    `json:"method,omitempty"`
+	Exception     string    `json:"exception,omitempty"`
+	ExceptionType string    `json:"exceptionType,omitempty"`
+}
+
+// LogFile comes from the /log/file and /log/file/update paths in all apps.
+type LogFile struct {
+	ID            int64     `json:"id"`
+	Filename      string    `json:"filename"`
+	LastWriteTime time.Time `json:"lastWriteTime"`
+	ContentsURL   string    `json:"contentsUrl"`
+	DownloadURL   string    `json:"downloadUrl"`
+}
+
 // QueueDeleteOpts are the extra inputs when deleting an item from the Activity Queue.
 // Set these appropriately for your expectations. All inputs are the same in all apps.
 // Providing this input to the QueueDelete methods is optional; nil sets the defaults shown.
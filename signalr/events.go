@@ -0,0 +1,73 @@
+package signalr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNoResource is returned by Decode when an event has no resource, like a sync event.
+var ErrNoResource = errors.New("event has no resource")
+
+// Event is a single update pushed by a Starr app. Name says what changed, and Action says how.
+// Resource holds the changed item, and its type depends on Name. Decode it into the matching
+// type from an app package, ie. a "command" event from Sonarr decodes into sonarr.CommandResponse.
+type Event struct {
+	Name     string          `json:"name"`
+	Body     json.RawMessage `json:"body"`
+	Action   string          `json:"-"`
+	Resource json.RawMessage `json:"-"`
+}
+
+// These are the event names the Starr apps send. Not every app sends every event.
+const (
+	// NameReconnected is not sent by the apps. The client sends it after it reconnects to the
+	// hub, because updates may have been missed. Refresh any state you keep when you get one.
+	NameReconnected    = "reconnected"
+	NameAlbum          = "album"
+	NameArtist         = "artist"
+	NameAuthor         = "author"
+	NameBlocklist      = "blocklist"
+	NameBook           = "book"
+	NameCalendar       = "calendar"
+	NameCommand        = "command"
+	NameDownloadClient = "downloadclient"
+	NameEpisode        = "episode"
+	NameEpisodeFile    = "episodefile"
+	NameHealth         = "health"
+	NameIndexer        = "indexer"
+	NameMovie          = "movie"
+	NameMovieFile      = "moviefile"
+	NameQueue          = "queue"
+	NameQueueDetails   = "queue/details"
+	NameQueueStatus    = "queue/status"
+	NameRootFolder     = "rootfolder"
+	NameSeries         = "series"
+	NameSystemTask     = "system/task"
+	NameTag            = "tag"
+	NameTrackFile      = "trackfile"
+	NameVersion        = "version"
+	NameWantedCutoff   = "wanted/cutoff"
+	NameWantedMissing  = "wanted/missing"
+)
+
+// These are the actions an event may have.
+const (
+	ActionSync    = "sync" // The list changed, and should be fetched again. No Resource is included.
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// Decode unmarshals the event's Resource into the provided pointer.
+func (e *Event) Decode(output interface{}) error {
+	if len(e.Resource) == 0 {
+		return fmt.Errorf("%w: %s", ErrNoResource, e.Name)
+	}
+
+	if err := json.Unmarshal(e.Resource, output); err != nil {
+		return fmt.Errorf("decoding %s event resource: %w", e.Name, err)
+	}
+
+	return nil
+}
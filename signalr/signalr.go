@@ -0,0 +1,359 @@
+// Package signalr provides a client for the real-time messages hub in every Starr app.
+// The apps push live updates for queue progress, command status, health and
+// media changes over /signalr/messages. This package connects to that hub with
+// the credentials in a starr.Config, speaks the SignalR JSON protocol over a
+// websocket, and delivers each update as an Event on a Go channel.
+// The client reconnects automatically until its context is canceled.
+//
+//	client := signalr.New(starr.New(apiKey, "http://localhost:8989", 0))
+//	events, err := client.Start(ctx)
+//	for event := range events {
+//		fmt.Println(event.Name, event.Action)
+//	}
+package signalr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+// Defaults for Client. Used when the respective Client value is zero.
+const (
+	DefaultBuffer           = 100
+	DefaultReconnectWait    = 2 * time.Second
+	DefaultMaxReconnectWait = time.Minute
+	// KeepAliveInterval is how often the client pings the hub.
+	KeepAliveInterval = 15 * time.Second
+	// ServerTimeout is how long the client waits for any message from the hub before reconnecting.
+	ServerTimeout = 30 * time.Second
+)
+
+// bpHub is the path to the messages hub in every Starr app.
+const bpHub = "/signalr/messages"
+
+// recordSeparator terminates every SignalR JSON protocol message.
+const recordSeparator = '\x1e'
+
+// SignalR message types we care about.
+const (
+	msgInvocation = 1
+	msgPing       = 6
+	msgClose      = 7
+)
+
+// ErrHubClosed is returned when the hub closes the connection with an error.
+var ErrHubClosed = errors.New("signalr hub closed the connection")
+
+// Client connects to the messages hub of a Starr app. Create one with New.
+// Set the exported members before calling Start.
+type Client struct {
+	// Buffer is the size of the events channel. Default is DefaultBuffer.
+	Buffer int
+	// ReconnectWait is how long to wait before the first reconnect attempt.
+	// It doubles after each failed attempt, up to MaxReconnectWait.
+	ReconnectWait    time.Duration
+	MaxReconnectWait time.Duration
+	// Errorf receives connection errors. They are otherwise discarded while reconnecting.
+	Errorf func(string, ...interface{})
+	config *starr.Config
+}
+
+// New returns a hub client that uses the URL, API key and HTTP transport from the provided config.
+// The values are copied, so the config is not changed, and later changes to it are not seen.
+// The HTTP client's transport must be nil or an *http.Transport. Start returns ErrTransport for any
+// other RoundTripper, such as a debuglog wrapper, because a websocket cannot be opened through it.
+func New(config *starr.Config) *Client {
+	copied := &starr.Config{
+		APIKey:   config.APIKey,
+		URL:      strings.TrimSuffix(config.URL, "/"),
+		HTTPPass: config.HTTPPass,
+		HTTPUser: config.HTTPUser,
+		Username: config.Username,
+		Password: config.Password,
+		Retry:    config.Retry,
+		Limiter:  config.Limiter,
+		Client:   config.Client,
+	}
+
+	if copied.Client == nil {
+		copied.Client = starr.Client(0, false)
+	}
+
+	return &Client{config: copied}
+}
+
+// Start connects to the hub and returns a channel of events. The first connection must
+// succeed, or an error is returned. After that, the client reconnects automatically,
+// and sends an event named NameReconnected each time it does. The channel is closed after
+// the context is canceled. If the channel is not read, new events block the connection.
+func (c *Client) Start(ctx context.Context) (<-chan *Event, error) {
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := c.Buffer
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+
+	events := make(chan *Event, buffer)
+	go c.run(ctx, conn, events)
+
+	return events, nil
+}
+
+// run reads from the hub, and reconnects, until the context is canceled.
+func (c *Client) run(ctx context.Context, conn *wsConn, events chan<- *Event) {
+	defer close(events)
+
+	for {
+		err := c.read(ctx, conn, events)
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.errorf("signalr: connection lost, reconnecting: %v", err)
+
+		if conn = c.reconnect(ctx); conn == nil {
+			return
+		}
+
+		select {
+		case events <- &Event{Name: NameReconnected}:
+		case <-ctx.Done():
+			conn.Close()
+			return
+		}
+	}
+}
+
+// reconnect connects to the hub with a backoff. Returns nil if the context is canceled first.
+func (c *Client) reconnect(ctx context.Context) *wsConn {
+	wait, maxWait := c.ReconnectWait, c.MaxReconnectWait
+	if wait <= 0 {
+		wait = DefaultReconnectWait
+	}
+
+	if maxWait <= 0 {
+		maxWait = DefaultMaxReconnectWait
+	}
+
+	for {
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		conn, err := c.connect(ctx)
+		if err == nil {
+			return conn
+		}
+
+		c.errorf("signalr: reconnecting: %v", err)
+
+		if wait *= 2; wait > maxWait {
+			wait = maxWait
+		}
+	}
+}
+
+// read processes messages from one connection until it fails, or the context is canceled.
+func (c *Client) read(ctx context.Context, conn *wsConn, events chan<- *Event) error {
+	var lastRead atomic.Int64
+
+	lastRead.Store(time.Now().UnixNano())
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go c.keepAlive(ctx, conn, &lastRead, done)
+
+	for {
+		data, err := conn.readMessage()
+		if err != nil {
+			return err
+		}
+
+		lastRead.Store(time.Now().UnixNano())
+
+		for _, record := range bytes.Split(data, []byte{recordSeparator}) {
+			if len(record) == 0 {
+				continue
+			}
+
+			event, err := parseMessage(record)
+			if err != nil {
+				return err
+			} else if event == nil {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return fmt.Errorf("sending event: %w", ctx.Err())
+			}
+		}
+	}
+}
+
+// keepAlive pings the hub, and closes the connection if the hub goes quiet or the context is canceled.
+func (c *Client) keepAlive(ctx context.Context, conn *wsConn, lastRead *atomic.Int64, done chan struct{}) {
+	defer conn.Close() // this also makes the reader return.
+
+	ticker := time.NewTicker(KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, lastRead.Load())) > ServerTimeout {
+				c.errorf("signalr: no message from hub in %v", ServerTimeout)
+				return
+			}
+
+			if err := conn.writeFrame(opText, []byte(`{"type":6}`+string(recordSeparator))); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// connect negotiates a connection token, opens the websocket, and completes the SignalR handshake.
+func (c *Client) connect(ctx context.Context) (*wsConn, error) {
+	token, err := c.negotiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := make(url.Values)
+	query.Set("id", token)
+	query.Set("access_token", c.config.APIKey)
+
+	conn, err := dial(ctx, c.config, c.config.URL+bpHub+"?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.handshake(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// handshake selects the JSON protocol, and waits for the hub to accept it.
+func (c *Client) handshake(conn *wsConn) error {
+	if err := conn.writeFrame(opText, []byte(`{"protocol":"json","version":1}`+string(recordSeparator))); err != nil {
+		return err
+	}
+
+	data, err := conn.readMessage()
+	if err != nil {
+		return err
+	}
+
+	var reply struct {
+		Error string `json:"error"`
+	}
+
+	record, _, _ := bytes.Cut(data, []byte{recordSeparator})
+	if err := json.Unmarshal(record, &reply); err != nil {
+		return fmt.Errorf("%w: decoding handshake reply: %v", ErrBadHandshake, err) //nolint:errorlint
+	} else if reply.Error != "" {
+		return fmt.Errorf("%w: %s", ErrBadHandshake, reply.Error)
+	}
+
+	return nil
+}
+
+// negotiate requests a connection token from the hub.
+func (c *Client) negotiate(ctx context.Context) (string, error) {
+	req := starr.Request{URI: bpHub + "/negotiate", Query: make(url.Values)}
+	req.Query.Set("negotiateVersion", "1")
+	req.Query.Set("access_token", c.config.APIKey)
+
+	resp, err := c.config.Req(ctx, http.MethodPost, req)
+	if err != nil {
+		return "", fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	var output struct {
+		ConnectionID    string `json:"connectionId"`
+		ConnectionToken string `json:"connectionToken"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		return "", fmt.Errorf("decoding negotiate response: %w", err)
+	}
+
+	if output.ConnectionToken != "" {
+		return output.ConnectionToken, nil
+	}
+
+	return output.ConnectionID, nil
+}
+
+func (c *Client) errorf(msg string, v ...interface{}) {
+	if c.Errorf != nil {
+		c.Errorf(msg, v...)
+	}
+}
+
+// parseMessage turns a single SignalR message into an Event. Returns nil for messages that are not events.
+func parseMessage(record []byte) (*Event, error) {
+	var msg struct {
+		Type      int               `json:"type"`
+		Target    string            `json:"target"`
+		Arguments []json.RawMessage `json:"arguments"`
+		Error     string            `json:"error"`
+	}
+
+	if err := json.Unmarshal(record, &msg); err != nil {
+		return nil, fmt.Errorf("decoding signalr message: %w", err)
+	}
+
+	switch {
+	case msg.Type == msgClose:
+		return nil, fmt.Errorf("%w: %s", ErrHubClosed, msg.Error)
+	case msg.Type != msgInvocation || len(msg.Arguments) == 0:
+		return nil, nil //nolint:nilnil // pings and other messages are not events.
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(msg.Arguments[0], event); err != nil {
+		return nil, fmt.Errorf("decoding signalr event: %w", err)
+	}
+
+	var body struct {
+		Action   string          `json:"action"`
+		Resource json.RawMessage `json:"resource"`
+	}
+
+	// Not every body has an action and resource, so errors are ignored.
+	_ = json.Unmarshal(event.Body, &body)
+	event.Action, event.Resource = body.Action, body.Resource
+
+	return event, nil
+}
@@ -0,0 +1,91 @@
+package signalr_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/signalr"
+	"github.com/BSFishy/starr/signalr/signalrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	hub := signalrtest.NewStubHub()
+	defer hub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	config := &starr.Config{APIKey: "mockAPIkey", URL: hub.URL + "/"}
+	client := signalr.New(config)
+	client.ReconnectWait = time.Millisecond
+
+	assert.Nil(t, config.Client, "the caller's config must not be changed")
+	assert.Equal(t, hub.URL+"/", config.URL, "the caller's config must not be changed")
+
+	events, err := client.Start(ctx)
+	require.NoError(t, err)
+	require.NoError(t, hub.WaitForClient(ctx))
+
+	// Receive an event and decode it.
+	require.NoError(t, hub.Send(signalr.NameCommand, signalr.ActionUpdated, map[string]interface{}{
+		"id": 12, "name": "RssSync", "status": "completed",
+	}))
+
+	event := <-events
+	assert.Equal(t, signalr.NameCommand, event.Name)
+	assert.Equal(t, signalr.ActionUpdated, event.Action)
+
+	var command struct {
+		ID     int64  `json:"id"`
+		Status string `json:"status"`
+	}
+
+	require.NoError(t, event.Decode(&command))
+	assert.EqualValues(t, 12, command.ID)
+	assert.Equal(t, starr.CommandCompleted, command.Status)
+
+	// Sync events have no resource.
+	require.NoError(t, hub.Send(signalr.NameQueue, signalr.ActionSync, nil))
+
+	event = <-events
+	assert.Equal(t, signalr.ActionSync, event.Action)
+	require.ErrorIs(t, event.Decode(&command), signalr.ErrNoResource)
+
+	// The client must reconnect after the hub drops it.
+	hub.Disconnect()
+	require.NoError(t, hub.WaitForClient(ctx))
+
+	event = <-events
+	assert.Equal(t, signalr.NameReconnected, event.Name)
+
+	require.NoError(t, hub.Send(signalr.NameHealth, signalr.ActionSync, nil))
+	assert.Equal(t, signalr.NameHealth, (<-events).Name)
+
+	// The channel must close when the context is canceled.
+	cancel()
+
+	for range events { //nolint:revive // drain.
+	}
+}
+
+type wrappedTransport struct{ http.RoundTripper }
+
+func TestClientTransport(t *testing.T) {
+	t.Parallel()
+
+	hub := signalrtest.NewStubHub()
+	defer hub.Close()
+
+	config := starr.New("mockAPIkey", hub.URL, 0)
+	config.Client.Transport = wrappedTransport{http.DefaultTransport}
+
+	_, err := signalr.New(config).Start(context.Background())
+	require.ErrorIs(t, err, signalr.ErrTransport, "a transport that cannot be copied must not be replaced")
+}
@@ -0,0 +1,274 @@
+// Package signalrtest provides a stub messages hub for testing code that uses the signalr package.
+package signalrtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // required by the websocket protocol.
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// These match the values in the signalr package.
+const (
+	bpHub           = "/signalr/messages"
+	recordSeparator = '\x1e'
+	msgInvocation   = 1
+	websocketGUID   = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	clientBuffer    = 100
+)
+
+// Websocket frame opcodes the stub hub uses.
+const (
+	opText  byte = 0x1
+	opClose byte = 0x8
+	opPing  byte = 0x9
+	opPong  byte = 0xA
+)
+
+// errClosed is returned when a client closes its connection.
+var errClosed = errors.New("websocket connection closed")
+
+// StubHub is a minimal messages hub that runs on a local test server.
+// Use it to test code that consumes events without a running Starr app.
+// Point a starr.Config at StubHub.URL; any API key is accepted.
+type StubHub struct {
+	*httptest.Server
+	mu      sync.Mutex
+	conns   map[*conn]struct{}
+	clients chan struct{}
+}
+
+// conn is the server side of a websocket connection. Servers do not mask the frames they send.
+type conn struct {
+	net.Conn
+	reader *bufio.Reader
+	mu     sync.Mutex
+}
+
+// NewStubHub starts and returns a stub messages hub. Close it when finished.
+func NewStubHub() *StubHub {
+	hub := &StubHub{
+		conns:   make(map[*conn]struct{}),
+		clients: make(chan struct{}, clientBuffer),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(bpHub+"/negotiate", hub.negotiate)
+	mux.HandleFunc(bpHub, hub.accept)
+	hub.Server = httptest.NewServer(mux)
+
+	return hub
+}
+
+// WaitForClient blocks until a client completes a handshake, or the context is canceled.
+// Each handshake satisfies one call, including reconnects.
+func (h *StubHub) WaitForClient(ctx context.Context) error {
+	select {
+	case <-h.clients:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for client: %w", ctx.Err())
+	}
+}
+
+// Send delivers an event to every connected client. The resource is encoded as JSON.
+func (h *StubHub) Send(name, action string, resource interface{}) error {
+	body := map[string]interface{}{"action": action}
+	if resource != nil {
+		body["resource"] = resource
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"type":      msgInvocation,
+		"target":    "receiveMessage",
+		"arguments": []interface{}{map[string]interface{}{"name": name, "body": body}},
+	})
+	if err != nil {
+		return fmt.Errorf("encoding event: %w", err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for conn := range h.conns {
+		if err := conn.writeFrame(opText, append(data, recordSeparator)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Disconnect drops every connected client, like an app restart would. Clients reconnect on their own.
+func (h *StubHub) Disconnect() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for conn := range h.conns {
+		conn.Close()
+		delete(h.conns, conn)
+	}
+}
+
+// Close drops every client and shuts down the test server.
+func (h *StubHub) Close() {
+	h.Disconnect()
+	h.Server.Close()
+}
+
+func (h *StubHub) negotiate(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+	_, _ = writer.Write([]byte(`{"negotiateVersion":1,"connectionId":"stub","connectionToken":"stub-token",` +
+		`"availableTransports":[{"transport":"WebSockets","transferFormats":["Text","Binary"]}]}`))
+}
+
+func (h *StubHub) accept(writer http.ResponseWriter, req *http.Request) {
+	hijacker, ok := writer.(http.Hijacker)
+	if !ok || req.Header.Get("Sec-Websocket-Key") == "" {
+		http.Error(writer, "websocket upgrade required", http.StatusBadRequest)
+		return
+	}
+
+	netConn, buf, err := hijacker.Hijack()
+	if err != nil {
+		return
+	}
+
+	_, _ = buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(req.Header.Get("Sec-Websocket-Key")) + "\r\n\r\n")
+	if err := buf.Flush(); err != nil {
+		netConn.Close()
+		return
+	}
+
+	conn := &conn{Conn: netConn, reader: buf.Reader}
+
+	// The first message is the protocol handshake.
+	if _, err := conn.readMessage(); err != nil {
+		conn.Close()
+		return
+	}
+
+	if err := conn.writeFrame(opText, []byte("{}"+string(recordSeparator))); err != nil {
+		conn.Close()
+		return
+	}
+
+	h.mu.Lock()
+	h.conns[conn] = struct{}{}
+	h.mu.Unlock()
+
+	select {
+	case h.clients <- struct{}{}:
+	default:
+	}
+
+	// Discard pings until the client goes away.
+	for {
+		if data, err := conn.readMessage(); err != nil || bytes.Contains(data, []byte(`"type":7`)) {
+			break
+		}
+	}
+
+	h.mu.Lock()
+	delete(h.conns, conn)
+	h.mu.Unlock()
+	conn.Close()
+}
+
+// acceptKey returns the Sec-WebSocket-Accept value for a Sec-WebSocket-Key.
+func acceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID)) //nolint:gosec // required by the websocket protocol.
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// writeFrame writes a single, final, unmasked frame.
+func (c *conn) writeFrame(opcode byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	frame := []byte{0x80 | opcode}
+
+	switch size := len(payload); {
+	case size < 126: //nolint:mnd // 7 bit length.
+		frame = append(frame, byte(size))
+	case size <= 0xFFFF:
+		frame = binary.BigEndian.AppendUint16(append(frame, 126), uint16(size)) //nolint:mnd // 16 bit length.
+	default:
+		frame = binary.BigEndian.AppendUint64(append(frame, 127), uint64(size)) //nolint:mnd // 64 bit length.
+	}
+
+	if _, err := c.Write(append(frame, payload...)); err != nil {
+		return fmt.Errorf("writing websocket frame: %w", err)
+	}
+
+	return nil
+}
+
+// readMessage returns the payload of the next data frame from the client. Clients
+// send each message in one masked frame. Pings are answered here.
+func (c *conn) readMessage() ([]byte, error) {
+	for {
+		var head [2]byte
+		if _, err := io.ReadFull(c.reader, head[:]); err != nil {
+			return nil, fmt.Errorf("reading websocket frame: %w", err)
+		}
+
+		opcode, size := head[0]&0x0F, uint64(head[1]&0x7F)
+
+		switch size {
+		case 126: //nolint:mnd // 16 bit length.
+			var ext [2]byte
+			if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+				return nil, fmt.Errorf("reading websocket frame: %w", err)
+			}
+
+			size = uint64(binary.BigEndian.Uint16(ext[:]))
+		case 127: //nolint:mnd // 64 bit length.
+			var ext [8]byte
+			if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+				return nil, fmt.Errorf("reading websocket frame: %w", err)
+			}
+
+			size = binary.BigEndian.Uint64(ext[:])
+		}
+
+		var key [4]byte
+		if head[1]&0x80 != 0 {
+			if _, err := io.ReadFull(c.reader, key[:]); err != nil {
+				return nil, fmt.Errorf("reading websocket frame: %w", err)
+			}
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(c.reader, payload); err != nil {
+			return nil, fmt.Errorf("reading websocket frame: %w", err)
+		}
+
+		for idx := range payload {
+			payload[idx] ^= key[idx%4]
+		}
+
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+		case opPong:
+		case opClose:
+			return nil, errClosed
+		default:
+			return payload, nil
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package signalr
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // required by the websocket protocol.
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/BSFishy/starr"
+)
+
+/* This file contains the minimal websocket (RFC 6455) implementation the SignalR hub needs. */
+
+// websocketGUID is appended to the client key to create the accept key.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxMessageSize protects us from a server sending a ridiculous frame length.
+const maxMessageSize = 32 << 20 // 32 MiB.
+
+// Websocket frame opcodes.
+const (
+	opContinuation byte = 0x0
+	opText         byte = 0x1
+	opBinary       byte = 0x2
+	opClose        byte = 0x8
+	opPing         byte = 0x9
+	opPong         byte = 0xA
+)
+
+// Errors returned by the websocket connection.
+var (
+	ErrConnectionClosed = errors.New("websocket connection closed")
+	ErrBadHandshake     = errors.New("websocket handshake failed")
+	ErrMessageTooLarge  = errors.New("websocket message too large")
+	// ErrTransport is returned when the config's HTTP client has a transport that cannot open a websocket.
+	ErrTransport = errors.New("websocket needs an *http.Transport")
+)
+
+// wsConn is the client side of a websocket connection. Reads must happen from one goroutine; writes are locked.
+type wsConn struct {
+	rwc    io.ReadWriteCloser
+	reader *bufio.Reader
+	mu     sync.Mutex
+}
+
+// dial opens a websocket connection to uri using the transport and headers from the starr config.
+func dial(ctx context.Context, config *starr.Config, uri string) (*wsConn, error) {
+	key := make([]byte, 16) //nolint:mnd // websocket keys are 16 bytes.
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generating websocket key: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext(websocket): %w", err)
+	}
+
+	config.SetHeaders(req)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-Websocket-Version", "13")
+	req.Header.Set("Sec-Websocket-Key", base64.StdEncoding.EncodeToString(key))
+
+	trans, err := transport(config)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (&http.Client{Transport: trans}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("httpClient.Do(websocket): %w", err)
+	}
+
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+
+	switch {
+	case resp.StatusCode != http.StatusSwitchingProtocols:
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		return nil, &starr.ReqError{Code: resp.StatusCode, Body: body, Header: resp.Header}
+	case resp.Header.Get("Sec-Websocket-Accept") != acceptKey(req.Header.Get("Sec-Websocket-Key")):
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: invalid accept key", ErrBadHandshake)
+	case !ok:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: response body is not writable", ErrBadHandshake)
+	}
+
+	return &wsConn{rwc: rwc, reader: bufio.NewReader(rwc)}, nil
+}
+
+// transport returns an HTTP/1.1 transport copied from the starr config, or from the default transport
+// when the config has none. Websockets cannot use HTTP/2, and the config's client timeout would kill a
+// long-lived connection. Any other RoundTripper, like one that wraps a transport, cannot be copied, so
+// it returns ErrTransport instead of dropping the caller's proxy and TLS settings.
+func transport(config *starr.Config) (*http.Transport, error) {
+	var trans *http.Transport
+
+	switch t := config.Client.Transport.(type) {
+	case nil:
+		trans, _ = http.DefaultTransport.(*http.Transport)
+	case *http.Transport:
+		trans = t
+	default:
+		return nil, fmt.Errorf("%w: config has a %T", ErrTransport, t)
+	}
+
+	trans = trans.Clone()
+	trans.ForceAttemptHTTP2 = false
+	trans.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+
+	return trans, nil
+}
+
+// acceptKey returns the Sec-WebSocket-Accept value for a Sec-WebSocket-Key.
+func acceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID)) //nolint:gosec // required by the websocket protocol.
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Close closes the underlying connection.
+func (w *wsConn) Close() error {
+	return w.rwc.Close() //nolint:wrapcheck
+}
+
+// writeFrame writes a single, final, masked frame. Clients must mask every frame they send.
+func (w *wsConn) writeFrame(opcode byte, payload []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	const (
+		finBit  = 0x80
+		maskBit = 0x80
+		len16   = 126
+		len64   = 127
+	)
+
+	frame := []byte{finBit | opcode, maskBit}
+
+	switch size := len(payload); {
+	case size < len16:
+		frame[1] |= byte(size)
+	case size <= 0xFFFF:
+		frame[1] |= len16
+		frame = binary.BigEndian.AppendUint16(frame, uint16(size))
+	default:
+		frame[1] |= len64
+		frame = binary.BigEndian.AppendUint64(frame, uint64(size))
+	}
+
+	key := make([]byte, 4) //nolint:mnd // mask keys are 4 bytes.
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("generating websocket mask: %w", err)
+	}
+
+	frame = append(frame, key...)
+	for idx, char := range payload {
+		frame = append(frame, char^key[idx%4])
+	}
+
+	if _, err := w.rwc.Write(frame); err != nil {
+		return fmt.Errorf("writing websocket frame: %w", err)
+	}
+
+	return nil
+}
+
+// readMessage returns the next complete data message. Control frames are handled here.
+func (w *wsConn) readMessage() ([]byte, error) {
+	var message []byte
+
+	for {
+		fin, opcode, payload, err := w.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err := w.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = w.writeFrame(opClose, payload)
+			return nil, ErrConnectionClosed
+		case opText, opBinary, opContinuation:
+		}
+
+		if message = append(message, payload...); len(message) > maxMessageSize {
+			return nil, ErrMessageTooLarge
+		}
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// readFrame reads a single frame and returns its payload.
+func (w *wsConn) readFrame() (bool, byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(w.reader, head[:]); err != nil {
+		return false, 0, nil, fmt.Errorf("reading websocket frame: %w", err)
+	}
+
+	fin, opcode, masked := head[0]&0x80 != 0, head[0]&0x0F, head[1]&0x80 != 0
+	size := uint64(head[1] & 0x7F)
+
+	switch size {
+	case 126: //nolint:mnd // 16 bit length.
+		var ext [2]byte
+		if _, err := io.ReadFull(w.reader, ext[:]); err != nil {
+			return false, 0, nil, fmt.Errorf("reading websocket frame: %w", err)
+		}
+
+		size = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127: //nolint:mnd // 64 bit length.
+		var ext [8]byte
+		if _, err := io.ReadFull(w.reader, ext[:]); err != nil {
+			return false, 0, nil, fmt.Errorf("reading websocket frame: %w", err)
+		}
+
+		size = binary.BigEndian.Uint64(ext[:])
+	}
+
+	if size > maxMessageSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	var key [4]byte
+	if masked {
+		if _, err := io.ReadFull(w.reader, key[:]); err != nil {
+			return false, 0, nil, fmt.Errorf("reading websocket frame: %w", err)
+		}
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(w.reader, payload); err != nil {
+		return false, 0, nil, fmt.Errorf("reading websocket frame: %w", err)
+	}
+
+	if masked {
+		for idx := range payload {
+			payload[idx] ^= key[idx%4]
+		}
+	}
+
+	return fin, opcode, payload, nil
+}
@@ -0,0 +1,119 @@
+package starr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/* This file contains helpers for the snapshot methods in each app package. */
+
+// SnapshotVersion is the version of the snapshot documents this library writes.
+// It increases when the document changes in a way older versions of this library cannot import.
+const SnapshotVersion = 1
+
+// Errors returned when importing a snapshot.
+var (
+	ErrSnapshotVersion = errors.New("unsupported snapshot version")
+	ErrSnapshotApp     = errors.New("snapshot is from a different app")
+)
+
+// SnapshotHeader identifies a snapshot document. It is embedded in every app's Snapshot.
+type SnapshotHeader struct {
+	Version int       `json:"version"`
+	App     App       `json:"app"`
+	Created time.Time `json:"created"`
+}
+
+// NewSnapshotHeader returns a header for a new snapshot from app.
+func NewSnapshotHeader(app App) SnapshotHeader {
+	return SnapshotHeader{Version: SnapshotVersion, App: app, Created: time.Now().UTC()}
+}
+
+// Check returns an error if the snapshot cannot be imported into app.
+func (s *SnapshotHeader) Check(app App) error {
+	if s.Version < 1 || s.Version > SnapshotVersion {
+		return fmt.Errorf("%w: %d, this library supports up to %d", ErrSnapshotVersion, s.Version, SnapshotVersion)
+	}
+
+	if s.App != app {
+		return fmt.Errorf("%w: %s, wanted %s", ErrSnapshotApp, s.App, app)
+	}
+
+	return nil
+}
+
+// ImportTags creates the tags that do not exist, by label, and returns a map of snapshot tag IDs to
+// the matching IDs in the target instance. Use RemapTags with the map to fix tag references.
+func ImportTags(
+	ctx context.Context,
+	existing, tags []*Tag,
+	add func(context.Context, *Tag) (*Tag, error),
+) (map[int]int, error) {
+	labels := make(map[string]int, len(existing))
+	for _, tag := range existing {
+		labels[strings.ToLower(tag.Label)] = tag.ID
+	}
+
+	ids := make(map[int]int, len(tags))
+
+	for _, tag := range tags {
+		if id, ok := labels[strings.ToLower(tag.Label)]; ok {
+			ids[tag.ID] = id
+			continue
+		}
+
+		added, err := add(ctx, &Tag{Label: tag.Label})
+		if err != nil {
+			return nil, fmt.Errorf("importing tag '%s': %w", tag.Label, err)
+		}
+
+		ids[tag.ID], labels[strings.ToLower(tag.Label)] = added.ID, added.ID
+	}
+
+	return ids, nil
+}
+
+// RemapTags returns the tag IDs converted with a map from ImportTags. Unknown IDs are dropped.
+func RemapTags(tags []int, ids map[int]int) []int {
+	if tags == nil {
+		return nil
+	}
+
+	output := make([]int, 0, len(tags))
+
+	for _, tag := range tags {
+		if id, ok := ids[tag]; ok {
+			output = append(output, id)
+		}
+	}
+
+	return output
+}
+
+// RemapFormatItems returns a quality profile's format items for the target instance. The apps require
+// every custom format to be listed, so the output has one item for each format in formats, in order.
+// Scores start from have, the profile's current items in the target, and the snapshot's items are
+// laid on top, with their format IDs converted using ids, a map of snapshot IDs to target IDs.
+func RemapFormatItems(formats []*Value, have, items []*FormatItem, ids map[int64]int64) []*FormatItem {
+	scores := make(map[int64]int64, len(formats))
+
+	for _, item := range have {
+		scores[item.Format] = item.Score
+	}
+
+	for _, item := range items {
+		if id, ok := ids[item.Format]; ok {
+			scores[id] = item.Score
+		}
+	}
+
+	output := make([]*FormatItem, len(formats))
+	for idx, format := range formats {
+		output[idx] = &FormatItem{Format: format.ID, Name: format.Name, Score: scores[format.ID]}
+	}
+
+	return output
+}
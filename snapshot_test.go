@@ -0,0 +1,55 @@
+package starr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotHeaderCheck(t *testing.T) {
+	t.Parallel()
+
+	header := starr.NewSnapshotHeader(starr.Radarr)
+	require.NoError(t, header.Check(starr.Radarr))
+	require.ErrorIs(t, header.Check(starr.Sonarr), starr.ErrSnapshotApp)
+
+	header.Version = starr.SnapshotVersion + 1
+	require.ErrorIs(t, header.Check(starr.Radarr), starr.ErrSnapshotVersion)
+}
+
+func TestImportTags(t *testing.T) {
+	t.Parallel()
+
+	existing := []*starr.Tag{{ID: 1, Label: "anime"}, {ID: 2, Label: "4K"}}
+	snapshot := []*starr.Tag{{ID: 5, Label: "4k"}, {ID: 6, Label: "kids"}, {ID: 7, Label: "Kids"}}
+	added := []string{}
+
+	ids, err := starr.ImportTags(context.Background(), existing, snapshot,
+		func(_ context.Context, tag *starr.Tag) (*starr.Tag, error) {
+			added = append(added, tag.Label)
+			return &starr.Tag{ID: 10, Label: tag.Label}, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kids"}, added, "only missing tags must be created, once")
+	assert.Equal(t, map[int]int{5: 2, 6: 10, 7: 10}, ids, "labels must be matched without case")
+	assert.Equal(t, []int{2, 10}, starr.RemapTags([]int{5, 6, 99}, ids), "unknown tags must be dropped")
+	assert.Nil(t, starr.RemapTags(nil, ids))
+}
+
+func TestRemapFormatItems(t *testing.T) {
+	t.Parallel()
+
+	formats := []*starr.Value{{ID: 1, Name: "x265"}, {ID: 2, Name: "HDR"}, {ID: 3, Name: "Extra"}}
+	have := []*starr.FormatItem{{Format: 1, Name: "x265", Score: -10}, {Format: 3, Name: "Extra", Score: 5}}
+	items := []*starr.FormatItem{{Format: 8, Name: "HDR", Score: 100}, {Format: 9, Name: "Gone", Score: 50}}
+
+	assert.Equal(t, []*starr.FormatItem{
+		{Format: 1, Name: "x265", Score: -10},
+		{Format: 2, Name: "HDR", Score: 100},
+		{Format: 3, Name: "Extra", Score: 5},
+	}, starr.RemapFormatItems(formats, have, items, map[int64]int64{8: 2}),
+		"every format must be listed, with the snapshot's scores on top of the existing scores")
+}
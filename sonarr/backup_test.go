@@ -0,0 +1,79 @@
+package sonarr_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadRestore(t *testing.T) {
+	t.Parallel()
+
+	const backup = "PK fake zip content"
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, path.Join("/", starr.API, sonarr.APIver, "system", "backup", "restore", "upload"), req.URL.Path)
+		assert.Equal(t, http.MethodPost, req.Method)
+
+		file, header, err := req.FormFile(starr.BackupRestoreField)
+		if !assert.NoError(t, err, "the backup must be sent as a multipart form file") {
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		body, _ := io.ReadAll(file)
+		assert.Equal(t, "sonarr_backup.zip", header.Filename)
+		assert.Equal(t, backup, string(body))
+		_, _ = writer.Write([]byte(`{"restartRequired":true}`))
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, client.UploadRestore("/tmp/sonarr_backup.zip", strings.NewReader(backup)))
+}
+
+func TestDownloadBackup(t *testing.T) {
+	t.Parallel()
+
+	const backup = "PK fake zip content"
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/login":
+			http.SetCookie(writer, &http.Cookie{Name: "SonarrAuth", Value: "yes"})
+			writer.WriteHeader(http.StatusFound)
+		case "/backup/manual/sonarr_backup.zip":
+			if _, err := req.Cookie("SonarrAuth"); err != nil {
+				writer.Header().Set("Location", "/login")
+				writer.WriteHeader(http.StatusFound)
+
+				return
+			}
+
+			_, _ = writer.Write([]byte(backup))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Username, config.Password = "user", "pass"
+
+	var buf bytes.Buffer
+
+	size, err := sonarr.New(config).DownloadBackup("/backup/manual/sonarr_backup.zip", &buf)
+	require.NoError(t, err, "the download must log in and try again")
+	assert.EqualValues(t, len(backup), size)
+	assert.Equal(t, backup, buf.String())
+}
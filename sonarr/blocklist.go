@@ -94,6 +94,19 @@ func (s *Sonarr) GetBlockListPageContext(ctx context.Context, params *starr.Page
 	return &output, nil
 }
 
+// BlockListPager returns a pager to walk the Sonarr block list one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (s *Sonarr) BlockListPager(params *starr.PageReq) *starr.Pager[*BlockListRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*BlockListRecord, int, error) {
+		page, err := s.GetBlockListPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteBlockList removes a single block list item.
 func (s *Sonarr) DeleteBlockList(listID int64) error {
 	return s.DeleteBlockListContext(context.Background(), listID)
@@ -14,7 +14,7 @@ import (
 const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v3/command endpoint.
-// This was created from the search command and may not support other commands yet.
+// Use the *Command() constructors, like SeriesSearchCommand(), to build a valid request.
 type CommandRequest struct {
 	SeasonNumber int     `json:"seasonNumber,omitempty"`
 	SeriesID     int64   `json:"seriesId,omitempty"`
@@ -23,6 +23,8 @@ type CommandRequest struct {
 	Files        []int64 `json:"files,omitempty"` // RenameFiles only
 	SeriesIDs    []int64 `json:"seriesIds,omitempty"`
 	EpisodeIDs   []int64 `json:"episodeIds,omitempty"`
+	Path         string  `json:"path,omitempty"`      // DownloadedEpisodesScan only
+	Monitored    *bool   `json:"monitored,omitempty"` // Missing and CutoffUnmet searches only
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -77,6 +79,10 @@ func (s *Sonarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 		return &output, nil
 	}
 
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
 	var body bytes.Buffer
 	if err := json.NewEncoder(&body).Encode(cmd); err != nil {
 		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCommand, err)
@@ -110,3 +116,54 @@ func (s *Sonarr) GetCommandStatusContext(ctx context.Context, commandID int64) (
 
 	return &output, nil
 }
+
+// WaitForCommand polls a command's status until it is completed, failed or aborted.
+// The final command status is returned. A command that does not complete
+// returns a *starr.CommandError, which matches starr.ErrCommandFailed.
+// pollInterval defaults to starr.DefaultCommandPollInterval when zero.
+func (s *Sonarr) WaitForCommand(commandID int64, pollInterval time.Duration) (*CommandResponse, error) {
+	return s.WaitForCommandContext(context.Background(), commandID, pollInterval)
+}
+
+// WaitForCommandContext polls a command's status until it is completed, failed or aborted.
+// Use a context with a deadline to limit how long this waits. See WaitForCommand for more.
+func (s *Sonarr) WaitForCommandContext(
+	ctx context.Context,
+	commandID int64,
+	pollInterval time.Duration,
+) (*CommandResponse, error) {
+	if commandID < 1 {
+		return nil, fmt.Errorf("%w: invalid command ID: %d", starr.ErrRequestError, commandID)
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = starr.DefaultCommandPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := s.GetCommandStatusContext(ctx, commandID)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Status == starr.CommandCompleted {
+			return status, nil
+		} else if starr.CommandDone(status.Status) {
+			return status, &starr.CommandError{
+				ID:      status.ID,
+				Name:    status.Name,
+				Status:  status.Status,
+				Message: status.Message,
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("waiting for command %d: %w", commandID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package sonarr_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"testing"
@@ -221,3 +222,27 @@ func TestGetCommandStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandConstructors(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, sonarr.SeriesSearchCommand(5).Validate())
+	require.NoError(t, sonarr.EpisodeSearchCommand(1, 2).Validate())
+	require.NoError(t, sonarr.RssSyncCommand().Validate())
+	require.NoError(t, sonarr.RefreshSeriesCommand(0).Validate(), "0 refreshes every series")
+	require.ErrorIs(t, sonarr.SeriesSearchCommand(0).Validate(), starr.ErrRequestError)
+	require.ErrorIs(t, sonarr.EpisodeSearchCommand().Validate(), starr.ErrRequestError)
+	require.ErrorIs(t, sonarr.RenameFilesCommand(5).Validate(), starr.ErrRequestError)
+	require.ErrorIs(t, sonarr.DownloadedEpisodesScanCommand("").Validate(), starr.ErrRequestError)
+
+	body, err := json.Marshal(sonarr.MissingEpisodeSearchCommand(false))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"MissingEpisodeSearch","monitored":false}`, string(body),
+		"false must be sent, or the server default applies")
+
+	// An invalid command must never reach the server.
+	client := sonarr.New(starr.New("mockAPIkey", "http://127.0.0.1:1", 0))
+	_, err = client.SendCommand(sonarr.SeasonSearchCommand(0, 1))
+	require.ErrorIs(t, err, starr.ErrRequestError)
+	assert.Equal(t, "request error: SeasonSearch command requires seriesId", err.Error())
+}
@@ -0,0 +1,140 @@
+package sonarr
+
+import (
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+/* This file contains constructors for the commands Sonarr supports. Use them with SendCommand. */
+
+// Validate returns an error if the command is missing a field its name requires.
+// SendCommand calls this before sending the request.
+func (c *CommandRequest) Validate() error {
+	var missing string
+
+	switch c.Name {
+	case "EpisodeSearch":
+		if len(c.EpisodeIDs) == 0 {
+			missing = "episodeIds"
+		}
+	case "SeriesSearch", "SeasonSearch":
+		if c.SeriesID < 1 {
+			missing = "seriesId"
+		}
+	case "RenameFiles":
+		if c.SeriesID < 1 {
+			missing = "seriesId"
+		} else if len(c.Files) == 0 {
+			missing = "files"
+		}
+	case "RenameSeries":
+		if len(c.SeriesIDs) == 0 {
+			missing = "seriesIds"
+		}
+	case "DownloadedEpisodesScan":
+		if c.Path == "" {
+			missing = "path"
+		}
+	}
+
+	if missing != "" {
+		return fmt.Errorf("%w: %s command requires %s", starr.ErrRequestError, c.Name, missing)
+	}
+
+	return nil
+}
+
+// EpisodeSearchCommand searches for the provided episodes.
+func EpisodeSearchCommand(episodeIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "EpisodeSearch", EpisodeIDs: episodeIDs}
+}
+
+// SeasonSearchCommand searches for every episode in a single season of a series.
+func SeasonSearchCommand(seriesID int64, seasonNumber int) *CommandRequest {
+	return &CommandRequest{Name: "SeasonSearch", SeriesID: seriesID, SeasonNumber: seasonNumber}
+}
+
+// SeriesSearchCommand searches for every monitored episode in a series.
+func SeriesSearchCommand(seriesID int64) *CommandRequest {
+	return &CommandRequest{Name: "SeriesSearch", SeriesID: seriesID}
+}
+
+// MissingEpisodeSearchCommand searches for missing episodes.
+// Set monitored to true to search monitored episodes, or false to search only unmonitored episodes.
+// Sonarr cannot search both in one command.
+func MissingEpisodeSearchCommand(monitored bool) *CommandRequest {
+	return &CommandRequest{Name: "MissingEpisodeSearch", Monitored: &monitored}
+}
+
+// CutoffUnmetEpisodeSearchCommand searches for upgrades to episodes that have not met their quality cutoff.
+// Set monitored to true to search monitored episodes, or false to search only unmonitored episodes.
+// Sonarr cannot search both in one command.
+func CutoffUnmetEpisodeSearchCommand(monitored bool) *CommandRequest {
+	return &CommandRequest{Name: "CutoffUnmetEpisodeSearch", Monitored: &monitored}
+}
+
+// RefreshSeriesCommand refreshes metadata and rescans files for a series. Use 0 to refresh every series.
+func RefreshSeriesCommand(seriesID int64) *CommandRequest {
+	return &CommandRequest{Name: "RefreshSeries", SeriesID: seriesID}
+}
+
+// RescanSeriesCommand rescans the files on disk for a series. Use 0 to rescan every series.
+func RescanSeriesCommand(seriesID int64) *CommandRequest {
+	return &CommandRequest{Name: "RescanSeries", SeriesID: seriesID}
+}
+
+// RenameFilesCommand renames the provided episode files that belong to a series.
+func RenameFilesCommand(seriesID int64, fileIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameFiles", SeriesID: seriesID, Files: fileIDs}
+}
+
+// RenameSeriesCommand renames every episode file in the provided series.
+func RenameSeriesCommand(seriesIDs ...int64) *CommandRequest {
+	return &CommandRequest{Name: "RenameSeries", SeriesIDs: seriesIDs}
+}
+
+// DownloadedEpisodesScanCommand imports the completed downloads found in a folder.
+func DownloadedEpisodesScanCommand(path string) *CommandRequest {
+	return &CommandRequest{Name: "DownloadedEpisodesScan", Path: path}
+}
+
+// RssSyncCommand checks every RSS enabled indexer for new releases.
+func RssSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "RssSync"}
+}
+
+// RefreshMonitoredDownloadsCommand checks the download clients for finished downloads.
+func RefreshMonitoredDownloadsCommand() *CommandRequest {
+	return &CommandRequest{Name: "RefreshMonitoredDownloads"}
+}
+
+// ImportListSyncCommand syncs every import list.
+func ImportListSyncCommand() *CommandRequest {
+	return &CommandRequest{Name: "ImportListSync"}
+}
+
+// BackupCommand creates a manual backup. See CreateBackup for a method that waits for it.
+func BackupCommand() *CommandRequest {
+	return &CommandRequest{Name: starr.BackupCommand}
+}
+
+// CheckHealthCommand runs all health checks.
+func CheckHealthCommand() *CommandRequest {
+	return &CommandRequest{Name: "CheckHealth"}
+}
+
+// HousekeepingCommand runs the database housekeeping tasks.
+func HousekeepingCommand() *CommandRequest {
+	return &CommandRequest{Name: "Housekeeping"}
+}
+
+// ClearBlocklistCommand removes every item from the block list.
+func ClearBlocklistCommand() *CommandRequest {
+	return &CommandRequest{Name: "ClearBlocklist"}
+}
+
+// CleanUpRecycleBinCommand deletes old files from the recycle bin.
+func CleanUpRecycleBinCommand() *CommandRequest {
+	return &CommandRequest{Name: "CleanUpRecycleBin"}
+}
@@ -0,0 +1,139 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/BSFishy/starr"
+)
+
+// SyncCustomFormats creates or updates custom formats by name, then sets custom format scores on quality profiles.
+// Read formats with starr.ReadCustomFormats. Formats that already match are not updated.
+// This data and these endpoints do not exist in Sonarr v3; this is v4 only.
+func (s *Sonarr) SyncCustomFormats(
+	formats []*starr.CustomFormatFile,
+	profiles ...*starr.ProfileScores,
+) (*starr.FormatSync, error) {
+	return s.SyncCustomFormatsContext(context.Background(), formats, profiles...)
+}
+
+// SyncCustomFormatsContext creates or updates custom formats by name, then sets custom format scores on quality profiles.
+// Read formats with starr.ReadCustomFormats. Formats that already match are not updated.
+// The output lists what changed, and is returned with any error.
+func (s *Sonarr) SyncCustomFormatsContext(
+	ctx context.Context,
+	formats []*starr.CustomFormatFile,
+	profiles ...*starr.ProfileScores,
+) (*starr.FormatSync, error) {
+	output := &starr.FormatSync{}
+
+	ids, err := s.syncCustomFormats(ctx, formats, output)
+	if err != nil {
+		return output, err
+	}
+
+	if len(profiles) == 0 {
+		return output, nil
+	}
+
+	existing, err := s.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return output, err
+	}
+
+	for _, scores := range profiles {
+		profile := findSyncProfile(existing, scores.Profile)
+		if profile == nil {
+			return output, fmt.Errorf("%w: '%s'", starr.ErrProfileNotFound, scores.Profile)
+		}
+
+		change, err := scores.SetScores(formats, ids,
+			&profile.FormatItems, &profile.MinFormatScore, &profile.CutoffFormatScore)
+		if err != nil {
+			return output, fmt.Errorf("quality profile '%s': %w", profile.Name, err)
+		} else if change == nil {
+			continue
+		}
+
+		if _, err := s.UpdateQualityProfileContext(ctx, profile); err != nil {
+			return output, fmt.Errorf("updating quality profile '%s': %w", profile.Name, err)
+		}
+
+		output.Profiles = append(output.Profiles, change)
+	}
+
+	return output, nil
+}
+
+// syncCustomFormats creates or updates formats, and returns every custom format's ID by lowercase name.
+func (s *Sonarr) syncCustomFormats(
+	ctx context.Context,
+	formats []*starr.CustomFormatFile,
+	output *starr.FormatSync,
+) (map[string]int64, error) {
+	existing, err := s.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int64, len(existing)+len(formats))
+	current := make(map[string]*CustomFormatOutput, len(existing))
+
+	for _, format := range existing {
+		ids[strings.ToLower(format.Name)] = format.ID
+		current[strings.ToLower(format.Name)] = format
+	}
+
+	for _, format := range formats {
+		var (
+			input CustomFormatInput
+			have  starr.CustomFormatFile
+			found = current[strings.ToLower(format.Name)]
+		)
+
+		if err := convert(format, &input); err != nil {
+			return nil, err
+		}
+
+		if found == nil {
+			added, err := s.AddCustomFormatContext(ctx, &input)
+			if err != nil {
+				return nil, fmt.Errorf("adding custom format '%s': %w", format.Name, err)
+			}
+
+			ids[strings.ToLower(format.Name)] = added.ID
+			output.Created = append(output.Created, format.Name)
+
+			continue
+		}
+
+		if err := convert(found, &have); err != nil {
+			return nil, err
+		}
+
+		if format.Matches(&have) {
+			output.Unchanged = append(output.Unchanged, format.Name)
+			continue
+		}
+
+		input.ID = found.ID
+		if _, err := s.UpdateCustomFormatContext(ctx, &input); err != nil {
+			return nil, fmt.Errorf("updating custom format '%s': %w", format.Name, err)
+		}
+
+		output.Updated = append(output.Updated, format.Name)
+	}
+
+	return ids, nil
+}
+
+func findSyncProfile(profiles []*QualityProfile, name string) *QualityProfile {
+	for _, profile := range profiles {
+		if strings.EqualFold(profile.Name, name) {
+			return profile
+		}
+	}
+
+	return nil
+}
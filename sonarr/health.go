@@ -0,0 +1,28 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpHealth = APIver + "/health"
+
+// GetHealth returns all active health check problems from the /api/v3/health endpoint.
+// An empty list means Sonarr found no problems with itself.
+func (s *Sonarr) GetHealth() ([]*starr.HealthCheck, error) {
+	return s.GetHealthContext(context.Background())
+}
+
+// GetHealthContext returns all active health check problems from the /api/v3/health endpoint.
+func (s *Sonarr) GetHealthContext(ctx context.Context) ([]*starr.HealthCheck, error) {
+	var output []*starr.HealthCheck
+
+	req := starr.Request{URI: bpHealth}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
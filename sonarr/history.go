@@ -120,6 +120,19 @@ func (s *Sonarr) GetHistoryPageContext(ctx context.Context, params *starr.PageRe
 	return &output, nil
 }
 
+// HistoryPager returns a pager to walk the Sonarr History (grabs/failures/completed) one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (s *Sonarr) HistoryPager(params *starr.PageReq) *starr.Pager[*HistoryRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*HistoryRecord, int, error) {
+		page, err := s.GetHistoryPageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // Fail marks the given history item as failed by id.
 func (s *Sonarr) Fail(historyID int64) error {
 	return s.FailContext(context.Background(), historyID)
@@ -111,6 +111,19 @@ func (s *Sonarr) GetQueuePageContext(ctx context.Context, params *starr.PageReq)
 	return &output, nil
 }
 
+// QueuePager returns a pager to walk the Sonarr Queue one page at a time.
+// Sort, filter and page size settings are taken from params, which may be nil.
+func (s *Sonarr) QueuePager(params *starr.PageReq) *starr.Pager[*QueueRecord] {
+	return starr.NewPager(params, func(ctx context.Context, params *starr.PageReq) ([]*QueueRecord, int, error) {
+		page, err := s.GetQueuePageContext(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Records, page.TotalRecords, nil
+	})
+}
+
 // DeleteQueue deletes an item from the Activity Queue.
 func (s *Sonarr) DeleteQueue(queueID int64, opts *starr.QueueDeleteOpts) error {
 	return s.DeleteQueueContext(context.Background(), queueID, opts)
@@ -12,7 +12,10 @@ import (
 	"github.com/BSFishy/starr"
 )
 
-const bpRelease = APIver + "/release"
+const (
+	bpRelease     = APIver + "/release"
+	bpReleasePush = bpRelease + "/push"
+)
 
 // Release is the output from the Sonarr release endpoint.
 type Release struct {
@@ -192,3 +195,48 @@ func (s *Sonarr) GrabReleaseContext(ctx context.Context, release *Release) (*Gra
 
 	return &output, nil
 }
+
+// PushRelease is the input needed to push a release from an external source into Sonarr.
+type PushRelease struct {
+	Title       string         `json:"title"`
+	DownloadURL string         `json:"downloadUrl"`
+	Protocol    starr.Protocol `json:"protocol"`
+	PublishDate time.Time      `json:"publishDate"`
+	Size        int64          `json:"size"`
+	Indexer     string         `json:"indexer"`
+}
+
+// PushRelease sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (s *Sonarr) PushRelease(input *PushRelease) ([]*Release, error) {
+	return s.PushReleaseContext(context.Background(), input)
+}
+
+// PushReleaseContext sends a release to the decision engine, and grabs it if it is accepted.
+// The output has the decision for the release. Check Approved and Rejections to find out why one was refused.
+func (s *Sonarr) PushReleaseContext(ctx context.Context, input *PushRelease) ([]*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleasePush, err)
+	}
+
+	var output json.RawMessage
+
+	req := starr.Request{URI: bpReleasePush, Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	// Newer versions return a list of decisions, older versions return only one.
+	var decisions []*Release
+	if err := json.Unmarshal(output, &decisions); err == nil {
+		return decisions, nil
+	}
+
+	var decision Release
+	if err := json.Unmarshal(output, &decision); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(%s): %w", bpReleasePush, err)
+	}
+
+	return []*Release{&decision}, nil
+}
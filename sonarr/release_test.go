@@ -0,0 +1,75 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, 5, 6, 7, 0, 0, 0, time.UTC)
+	request := `{"title":"Show.S01E01.1080p-GRP","downloadUrl":"http://rss/1.torrent","protocol":"torrent",` +
+		`"publishDate":"2024-05-06T07:00:00Z","size":1000,"indexer":"Scraper"}` + "\n"
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `[{"title":"Show.S01E01.1080p-GRP","approved":false,"rejections":["Not an upgrade"]}]`,
+			WithResponse: []*sonarr.Release{
+				{Title: "Show.S01E01.1080p-GRP", Rejections: []string{"Not an upgrade"}},
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "single",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"title":"Show.S01E01.1080p-GRP","approved":true}`,
+			WithResponse:    []*sonarr.Release{{Title: "Show.S01E01.1080p-GRP", Approved: true}},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "release", "push"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithResponse:    []*sonarr.Release(nil),
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.PushRelease(&sonarr.PushRelease{
+				Title:       "Show.S01E01.1080p-GRP",
+				DownloadURL: "http://rss/1.torrent",
+				Protocol:    starr.ProtocolTorrent,
+				PublishDate: published,
+				Size:        1000,
+				Indexer:     "Scraper",
+			})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/BSFishy/starr"
+)
+
+const bpSeriesEditor = bpSeries + "/editor"
+
+// BulkEdit is the input for the bulk series editor endpoint.
+// You may use starr.True(), starr.False(), starr.Int64(), and starr.String() to add data to the struct members.
+// MonitorNewItems accepts MonitorAll and MonitorNone. LanguageProfileID only works on Sonarr v3.
+type BulkEdit struct {
+	SeriesIDs          []int64         `json:"seriesIds"`
+	Monitored          *bool           `json:"monitored,omitempty"`
+	MonitorNewItems    MonitorType     `json:"monitorNewItems,omitempty"` // all, none
+	QualityProfileID   *int64          `json:"qualityProfileId,omitempty"`
+	LanguageProfileID  *int64          `json:"languageProfileId,omitempty"`
+	SeriesType         SeriesType      `json:"seriesType,omitempty"` // standard
+	SeasonFolder       *bool           `json:"seasonFolder,omitempty"`
+	RootFolderPath     *string         `json:"rootFolderPath,omitempty"` // path
+	Tags               []int           `json:"tags,omitempty"`           // [0]
+	ApplyTags          starr.ApplyTags `json:"applyTags,omitempty"`      // add
+	MoveFiles          *bool           `json:"moveFiles,omitempty"`
+	DeleteFiles        *bool           `json:"deleteFiles,omitempty"`            // delete only
+	AddImportExclusion *bool           `json:"addImportListExclusion,omitempty"` // delete only
+}
+
+// SeriesType is an enum used as SeriesType in the bulk editor.
+type SeriesType string
+
+// SeriesType constants.
+// https://sonarr.tv/docs/api/#/SeriesEditor/put_api_v3_series_editor
+const (
+	SeriesTypeStandard SeriesType = "standard"
+	SeriesTypeDaily    SeriesType = "daily"
+	SeriesTypeAnime    SeriesType = "anime"
+)
+
+// EditSeries allows bulk editing many series at once.
+func (s *Sonarr) EditSeries(editSeries *BulkEdit) ([]*Series, error) {
+	return s.EditSeriesContext(context.Background(), editSeries)
+}
+
+// EditSeriesContext allows bulk editing many series at once.
+func (s *Sonarr) EditSeriesContext(ctx context.Context, editSeries *BulkEdit) ([]*Series, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editSeries); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSeriesEditor, err)
+	}
+
+	var output []*Series
+
+	req := starr.Request{URI: bpSeriesEditor, Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteSeriesBulk bulk deletes series. Can also add them to the import list exclusions, and delete their files.
+// Use DeleteSeries to delete a single series.
+func (s *Sonarr) DeleteSeriesBulk(deleteSeries *BulkEdit) error {
+	return s.DeleteSeriesBulkContext(context.Background(), deleteSeries)
+}
+
+// DeleteSeriesBulkContext bulk deletes series. Can also add them to the import list exclusions, and delete their files.
+func (s *Sonarr) DeleteSeriesBulkContext(ctx context.Context, deleteSeries *BulkEdit) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(deleteSeries); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpSeriesEditor, err)
+	}
+
+	req := starr.Request{URI: bpSeriesEditor, Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
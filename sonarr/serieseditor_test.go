@@ -0,0 +1,116 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditSeries(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id":7,"monitored":true,"seasonFolder":true},{"id":3,"monitored":true,"seasonFolder":true}]`,
+			WithError:      nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:       []int64{7, 3},
+				Monitored:       starr.True(),
+				MonitorNewItems: sonarr.MonitorAll,
+				SeasonFolder:    starr.True(),
+			},
+			ExpectedRequest: `{"seriesIds":[7,3],"monitored":true,"monitorNewItems":"all","seasonFolder":true}` + "\n",
+			ExpectedMethod:  http.MethodPut,
+			WithResponse: []*sonarr.Series{
+				{ID: 7, Monitored: true, SeasonFolder: true},
+				{ID: 3, Monitored: true, SeasonFolder: true},
+			},
+		},
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":17,"seriesType":"anime","tags":[44,55,66]},` +
+				`{"id":13,"seriesType":"anime","tags":[44,55,66]}]`,
+			WithError: nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:         []int64{17, 13},
+				LanguageProfileID: starr.Int64(2),
+				SeriesType:        sonarr.SeriesTypeAnime,
+				RootFolderPath:    starr.String("/anime"),
+				Tags:              []int{44, 55, 66},
+				ApplyTags:         starr.TagsAdd,
+				MoveFiles:         starr.True(),
+			},
+			ExpectedRequest: `{"seriesIds":[17,13],"languageProfileId":2,"seriesType":"anime","rootFolderPath":"/anime",` +
+				`"tags":[44,55,66],"applyTags":"add","moveFiles":true}` + "\n",
+			ExpectedMethod: http.MethodPut,
+			WithResponse: []*sonarr.Series{
+				{ID: 17, SeriesType: "anime", Tags: []int{44, 55, 66}},
+				{ID: 13, SeriesType: "anime", Tags: []int{44, 55, 66}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditSeries(test.WithRequest.(*sonarr.BulkEdit))
+			require.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestDeleteSeriesBulk(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			WithError:      nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:          []int64{7, 3},
+				DeleteFiles:        starr.True(),
+				AddImportExclusion: starr.False(),
+			},
+			ExpectedRequest: `{"seriesIds":[7,3],"deleteFiles":true,"addImportListExclusion":false}` + "\n",
+			ExpectedMethod:  http.MethodDelete,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    starrtest.BodyNotFound,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithRequest:     &sonarr.BulkEdit{SeriesIDs: []int64{7}},
+			ExpectedRequest: `{"seriesIds":[7]}` + "\n",
+			ExpectedMethod:  http.MethodDelete,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteSeriesBulk(test.WithRequest.(*sonarr.BulkEdit))
+			require.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+		})
+	}
+}
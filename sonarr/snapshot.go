@@ -0,0 +1,360 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BSFishy/starr"
+)
+
+// Snapshot is a copy of the settings in a Sonarr instance. Create one with GetSnapshot or
+// ExportSnapshot, and copy it into another instance with ImportSnapshot.
+// Indexers, download clients, notifications, root folders, delay profiles and language profiles are
+// not included.
+type Snapshot struct {
+	starr.SnapshotHeader
+	Tags                 []*starr.Tag               `json:"tags"`
+	Naming               *Naming                    `json:"naming"`
+	MediaManagement      *MediaManagement           `json:"mediaManagement"`
+	IndexerConfig        *IndexerConfig             `json:"indexerConfig"`
+	DownloadClientConfig *DownloadClientConfig      `json:"downloadClientConfig"`
+	QualityDefinitions   []*QualityDefinition       `json:"qualityDefinitions"`
+	CustomFormats        []*CustomFormatOutput      `json:"customFormats"`
+	QualityProfiles      []*QualityProfile          `json:"qualityProfiles"`
+	ReleaseProfiles      []*ReleaseProfile          `json:"releaseProfiles"`
+	RemotePathMappings   []*starr.RemotePathMapping `json:"remotePathMappings"`
+}
+
+// GetSnapshot returns a copy of the settings in Sonarr.
+func (s *Sonarr) GetSnapshot() (*Snapshot, error) {
+	return s.GetSnapshotContext(context.Background())
+}
+
+// GetSnapshotContext returns a copy of the settings in Sonarr.
+func (s *Sonarr) GetSnapshotContext(ctx context.Context) (*Snapshot, error) {
+	var (
+		snap = &Snapshot{SnapshotHeader: starr.NewSnapshotHeader(starr.Sonarr)}
+		err  error
+	)
+
+	if snap.Tags, err = s.GetTagsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.Naming, err = s.GetNamingContext(ctx); err != nil {
+		return nil, err
+	} else if snap.MediaManagement, err = s.GetMediaManagementContext(ctx); err != nil {
+		return nil, err
+	} else if snap.IndexerConfig, err = s.GetIndexerConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.DownloadClientConfig, err = s.GetDownloadClientConfigContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityDefinitions, err = s.GetQualityDefinitionsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.CustomFormats, err = s.GetCustomFormatsContext(ctx); err != nil {
+		return nil, err
+	} else if snap.QualityProfiles, err = s.GetQualityProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.ReleaseProfiles, err = s.GetReleaseProfilesContext(ctx); err != nil {
+		return nil, err
+	} else if snap.RemotePathMappings, err = s.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// ExportSnapshot writes a JSON snapshot of the settings in Sonarr.
+func (s *Sonarr) ExportSnapshot(output io.Writer) error {
+	return s.ExportSnapshotContext(context.Background(), output)
+}
+
+// ExportSnapshotContext writes a JSON snapshot of the settings in Sonarr.
+func (s *Sonarr) ExportSnapshotContext(ctx context.Context, output io.Writer) error {
+	snap, err := s.GetSnapshotContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snap); err != nil {
+		return fmt.Errorf("json.Marshal(snapshot): %w", err)
+	}
+
+	return nil
+}
+
+// ImportSnapshot reads a JSON snapshot, and applies it to Sonarr with ApplySnapshot.
+func (s *Sonarr) ImportSnapshot(input io.Reader) error {
+	return s.ImportSnapshotContext(context.Background(), input)
+}
+
+// ImportSnapshotContext reads a JSON snapshot, and applies it to Sonarr with ApplySnapshot.
+func (s *Sonarr) ImportSnapshotContext(ctx context.Context, input io.Reader) error {
+	var snap Snapshot
+	if err := json.NewDecoder(input).Decode(&snap); err != nil {
+		return fmt.Errorf("json.Unmarshal(snapshot): %w", err)
+	}
+
+	return s.ApplySnapshotContext(ctx, &snap)
+}
+
+// ApplySnapshot copies the settings in a snapshot into Sonarr.
+func (s *Sonarr) ApplySnapshot(snap *Snapshot) error {
+	return s.ApplySnapshotContext(context.Background(), snap)
+}
+
+// ApplySnapshotContext copies the settings in a snapshot into Sonarr. Tags, custom formats, quality
+// profiles and release profiles are matched by name, and created when missing. IDs and tag references
+// are remapped to the IDs in this instance. Release profiles lose their indexer, because indexers are
+// not part of a snapshot. Stops at the first error.
+func (s *Sonarr) ApplySnapshotContext(ctx context.Context, snap *Snapshot) error {
+	if err := snap.Check(starr.Sonarr); err != nil {
+		return err
+	}
+
+	existing, err := s.GetTagsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := starr.ImportTags(ctx, existing, snap.Tags, s.AddTagContext)
+	if err != nil {
+		return err
+	}
+
+	if err := s.applySnapshotConfig(ctx, snap); err != nil {
+		return err
+	}
+
+	formats, err := s.applySnapshotFormats(ctx, snap.CustomFormats)
+	if err != nil {
+		return err
+	}
+
+	if err := s.applySnapshotProfiles(ctx, snap.QualityProfiles, formats); err != nil {
+		return err
+	}
+
+	if err := s.applySnapshotReleaseProfiles(ctx, snap, tags); err != nil {
+		return err
+	}
+
+	return s.applySnapshotMappings(ctx, snap.RemotePathMappings)
+}
+
+// applySnapshotConfig updates the settings that exist once per instance.
+func (s *Sonarr) applySnapshotConfig(ctx context.Context, snap *Snapshot) error {
+	if snap.Naming != nil {
+		if _, err := s.UpdateNamingContext(ctx, snap.Naming); err != nil {
+			return fmt.Errorf("importing naming: %w", err)
+		}
+	}
+
+	if snap.MediaManagement != nil {
+		if _, err := s.UpdateMediaManagementContext(ctx, snap.MediaManagement); err != nil {
+			return fmt.Errorf("importing media management: %w", err)
+		}
+	}
+
+	if snap.IndexerConfig != nil {
+		if _, err := s.UpdateIndexerConfigContext(ctx, snap.IndexerConfig); err != nil {
+			return fmt.Errorf("importing indexer config: %w", err)
+		}
+	}
+
+	if snap.DownloadClientConfig != nil {
+		if _, err := s.UpdateDownloadClientConfigContext(ctx, snap.DownloadClientConfig); err != nil {
+			return fmt.Errorf("importing download client config: %w", err)
+		}
+	}
+
+	if len(snap.QualityDefinitions) == 0 {
+		return nil
+	}
+
+	definitions, err := s.GetQualityDefinitionsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Quality IDs are the same in every instance, but definition IDs may not be.
+	ids := make(map[int64]int64, len(definitions))
+	for _, def := range definitions {
+		if def.Quality != nil {
+			ids[def.Quality.ID] = def.ID
+		}
+	}
+
+	update := []*QualityDefinition{}
+
+	for _, def := range snap.QualityDefinitions {
+		if def.Quality == nil {
+			continue
+		}
+
+		if id, ok := ids[def.Quality.ID]; ok {
+			copied := *def
+			copied.ID = id
+			update = append(update, &copied)
+		}
+	}
+
+	if _, err := s.UpdateQualityDefinitionsContext(ctx, update); err != nil {
+		return fmt.Errorf("importing quality definitions: %w", err)
+	}
+
+	return nil
+}
+
+// applySnapshotFormats creates or updates custom formats by name, and returns a map of snapshot IDs to new IDs.
+func (s *Sonarr) applySnapshotFormats(ctx context.Context, formats []*CustomFormatOutput) (map[int64]int64, error) {
+	existing, err := s.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]int64, len(existing))
+	for _, format := range existing {
+		names[strings.ToLower(format.Name)] = format.ID
+	}
+
+	ids := make(map[int64]int64, len(formats))
+
+	for _, format := range formats {
+		var input CustomFormatInput
+		if err := convert(format, &input); err != nil {
+			return nil, err
+		}
+
+		input.ID = names[strings.ToLower(format.Name)]
+
+		var output *CustomFormatOutput
+		if input.ID == 0 {
+			output, err = s.AddCustomFormatContext(ctx, &input)
+		} else {
+			output, err = s.UpdateCustomFormatContext(ctx, &input)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("importing custom format '%s': %w", format.Name, err)
+		}
+
+		ids[format.ID] = output.ID
+	}
+
+	return ids, nil
+}
+
+// applySnapshotProfiles creates or updates quality profiles by name, with their format scores remapped.
+// Every custom format in the instance is listed in each profile; formats not in the snapshot keep their scores.
+func (s *Sonarr) applySnapshotProfiles(ctx context.Context, profiles []*QualityProfile, formats map[int64]int64) error {
+	existing, err := s.GetQualityProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	customFormats, err := s.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := make([]*starr.Value, len(customFormats))
+	for idx, format := range customFormats {
+		values[idx] = &starr.Value{ID: format.ID, Name: format.Name}
+	}
+
+	names := make(map[string]*QualityProfile, len(existing))
+	for _, profile := range existing {
+		names[strings.ToLower(profile.Name)] = profile
+	}
+
+	for _, profile := range profiles {
+		var have []*starr.FormatItem
+
+		copied := *profile
+		copied.ID = 0
+
+		if found := names[strings.ToLower(profile.Name)]; found != nil {
+			copied.ID, have = found.ID, found.FormatItems
+		}
+
+		copied.FormatItems = starr.RemapFormatItems(values, have, profile.FormatItems, formats)
+
+		if copied.ID == 0 {
+			_, err = s.AddQualityProfileContext(ctx, &copied)
+		} else {
+			_, err = s.UpdateQualityProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing quality profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotReleaseProfiles imports release profiles, with their tags remapped.
+func (s *Sonarr) applySnapshotReleaseProfiles(ctx context.Context, snap *Snapshot, tags map[int]int) error {
+	profiles, err := s.GetReleaseProfilesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]int64, len(profiles))
+	for _, profile := range profiles {
+		names[strings.ToLower(profile.Name)] = profile.ID
+	}
+
+	for _, profile := range snap.ReleaseProfiles {
+		copied := *profile
+		copied.ID, copied.IndexerID = names[strings.ToLower(profile.Name)], 0
+		copied.Tags = starr.RemapTags(profile.Tags, tags)
+
+		if copied.ID == 0 {
+			_, err = s.AddReleaseProfileContext(ctx, &copied)
+		} else {
+			_, err = s.UpdateReleaseProfileContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing release profile '%s': %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applySnapshotMappings creates or updates remote path mappings, matched by host and remote path.
+func (s *Sonarr) applySnapshotMappings(ctx context.Context, mappings []*starr.RemotePathMapping) error {
+	existing, err := s.GetRemotePathMappingsContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]int64, len(existing))
+	for _, mapping := range existing {
+		ids[mapping.Host+mapping.RemotePath] = mapping.ID
+	}
+
+	for _, mapping := range mappings {
+		copied := *mapping
+		copied.ID = ids[mapping.Host+mapping.RemotePath]
+
+		if copied.ID == 0 {
+			_, err = s.AddRemotePathMappingContext(ctx, &copied)
+		} else {
+			_, err = s.UpdateRemotePathMappingContext(ctx, &copied)
+		}
+
+		if err != nil {
+			return fmt.Errorf("importing remote path mapping '%s': %w", mapping.RemotePath, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package sonarr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotBody returns the request body a client sends for input.
+func snapshotBody(t *testing.T, input interface{}) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(input))
+
+	return body.String()
+}
+
+func snapshotGet(uri, response string) *starrtest.MockData {
+	return &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, uri),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   response,
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":2,"label":"4k"}]`),
+		snapshotGet("config/naming", `{"id":1,"renameEpisodes":true}`),
+		snapshotGet("config/mediaManagement", `{"id":1}`),
+		snapshotGet("config/indexer", `{"id":1}`),
+		snapshotGet("config/downloadClient", `{"id":1}`),
+		snapshotGet("qualityDefinition", `[]`),
+		snapshotGet("customFormat", `[{"id":9,"name":"HDR"}]`),
+		snapshotGet("qualityProfile", `[{"id":3,"name":"HD","formatItems":[{"format":9,"name":"HDR","score":100}]}]`),
+		snapshotGet("releaseProfile", `[]`),
+		snapshotGet("remotePathMapping", `[]`),
+	)
+
+	var output bytes.Buffer
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, client.ExportSnapshot(&output))
+
+	var snap sonarr.Snapshot
+	require.NoError(t, json.Unmarshal(output.Bytes(), &snap))
+	assert.Equal(t, starr.Sonarr, snap.App)
+	assert.Equal(t, starr.SnapshotVersion, snap.Version)
+	assert.Equal(t, []*starr.Tag{{ID: 2, Label: "4k"}}, snap.Tags)
+	assert.True(t, snap.Naming.RenameEpisodes)
+	assert.Equal(t, int64(9), snap.QualityProfiles[0].FormatItems[0].Format)
+}
+
+func TestApplySnapshot(t *testing.T) {
+	t.Parallel()
+
+	snap := &sonarr.Snapshot{
+		SnapshotHeader: starr.NewSnapshotHeader(starr.Sonarr),
+		Tags:           []*starr.Tag{{ID: 5, Label: "4K"}},
+		CustomFormats:  []*sonarr.CustomFormatOutput{{ID: 8, Name: "HDR"}},
+		QualityProfiles: []*sonarr.QualityProfile{{
+			ID:          1,
+			Name:        "HD",
+			FormatItems: []*starr.FormatItem{{Format: 8, Name: "HDR", Score: 100}},
+		}},
+		ReleaseProfiles: []*sonarr.ReleaseProfile{{ID: 6, Name: "Block", IndexerID: 3, Tags: []int{5}}},
+	}
+
+	server := starrtest.GetMockSequence(t,
+		snapshotGet("tag", `[{"id":2,"label":"4k"}]`),
+		snapshotGet("customFormat", `[{"id":1,"name":"x265"}]`),
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "customFormat"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: snapshotBody(t, &sonarr.CustomFormatInput{Name: "HDR"}),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"id":9,"name":"HDR"}`,
+		},
+		snapshotGet("qualityProfile", `[{"id":3,"name":"hd","formatItems":[{"format":1,"name":"x265","score":-10}]}]`),
+		snapshotGet("customFormat", `[{"id":1,"name":"x265"},{"id":9,"name":"HDR"}]`),
+		&starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "qualityProfile", "3"),
+			ExpectedMethod: http.MethodPut,
+			ExpectedRequest: snapshotBody(t, &sonarr.QualityProfile{
+				ID:   3,
+				Name: "HD",
+				FormatItems: []*starr.FormatItem{
+					{Format: 1, Name: "x265", Score: -10}, // not in the snapshot, so the score is kept.
+					{Format: 9, Name: "HDR", Score: 100},  // remapped from format 8.
+				},
+			}),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"id":3}`,
+		},
+		snapshotGet("releaseProfile", `[]`),
+		&starrtest.MockData{
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "releaseProfile"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: snapshotBody(t, &sonarr.ReleaseProfile{Name: "Block", Tags: []int{2}}),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"id":1}`,
+		},
+		snapshotGet("remotePathMapping", `[]`),
+	)
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	require.NoError(t, client.ApplySnapshot(snap))
+}
+
+func TestImportSnapshotWrongApp(t *testing.T) {
+	t.Parallel()
+
+	server := starrtest.GetMockSequence(t) // no requests may be made.
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	err := client.ImportSnapshot(strings.NewReader(`{"version":1,"app":"Whisparr"}`))
+	require.ErrorIs(t, err, starr.ErrSnapshotApp)
+}
@@ -0,0 +1,110 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "missing") +
+				"?includeSeries=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=episodes.airDateUtc",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"episodes.airDateUtc","sortDirection":"ascending",` +
+				`"totalRecords":1,"records":[{"id":7,"seriesId":2,"episodeNumber":3,"seasonNumber":1,` +
+				`"title":"Pilot","monitored":true,"series":{"id":2,"title":"Show"}}]}`,
+			WithRequest: &starr.PageReq{},
+			WithResponse: &sonarr.Wanted{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "episodes.airDateUtc",
+				SortDirection: "ascending",
+				TotalRecords:  1,
+				Records: []*sonarr.Episode{{
+					ID:            7,
+					SeriesID:      2,
+					EpisodeNumber: 3,
+					SeasonNumber:  1,
+					Title:         "Pilot",
+					Monitored:     true,
+					Series:        &sonarr.Series{ID: 2, Title: "Show"},
+				}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "missing") +
+				"?includeSeries=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=episodes.airDateUtc",
+			ExpectedMethod: "GET",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   starrtest.BodyNotFound,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*sonarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			params, _ := test.WithRequest.(*starr.PageReq)
+			output, err := client.GetWantedMissingPage(params, true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetWantedCutoff(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "cutoff") +
+			"?includeSeries=true&monitored=false&page=1&pageSize=2&sortDirection=ascending&sortKey=episodes.airDateUtc",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `{"page":1,"pageSize":2,"sortKey":"episodes.airDateUtc","sortDirection":"ascending",` +
+			`"totalRecords":2,"records":[{"id":1},{"id":2}]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoff(2, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.TotalRecords)
+	assert.Len(t, output.Records, 2)
+}
+
+func TestGetWantedCutoffPageNil(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "cutoff") +
+			"?includeSeries=true&monitored=true&page=1&pageSize=10&sortDirection=ascending&sortKey=episodes.airDateUtc",
+		ExpectedMethod: "GET",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"page":1,"pageSize":10,"totalRecords":0,"records":[]}`,
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.GetWantedCutoffPage(nil, true)
+	require.NoError(t, err, "nil params must use the defaults")
+	assert.Empty(t, output.Records)
+}
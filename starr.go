@@ -19,6 +19,7 @@ package starr
 import (
 	"errors"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -47,6 +48,9 @@ var (
 // At a minimum, provide a URL and API Key.
 // HTTPUser and HTTPPass are used for Basic HTTP auth, if enabled (not common).
 // Username and Password are for non-API paths with native authentication enabled.
+// When they are set, non-API requests log in again and retry once if the session has expired.
+// Retry is optional, and allows failed requests to be retried with a backoff.
+// Limiter is optional, and throttles requests; create one with NewLimiter().
 type Config struct {
 	APIKey   string       `json:"apiKey"   toml:"api_key"   xml:"api_key"   yaml:"apiKey"`
 	URL      string       `json:"url"      toml:"url"       xml:"url"       yaml:"url"`
@@ -54,8 +58,11 @@ type Config struct {
 	HTTPUser string       `json:"httpUser" toml:"http_user" xml:"http_user" yaml:"httpUser"`
 	Username string       `json:"username" toml:"username"  xml:"username"  yaml:"username"`
 	Password string       `json:"password" toml:"password"  xml:"password"  yaml:"password"`
+	Retry    *RetryPolicy `json:"retry"    toml:"retry"     xml:"retry"     yaml:"retry"`
+	Limiter  *Limiter     `json:"-"        toml:"-"         xml:"-"         yaml:"-"`
 	Client   *http.Client `json:"-"        toml:"-"         xml:"-"         yaml:"-"`
-	cookie   bool         // this probably doesn't work right.
+	session  sync.Mutex   // protects the cookie jar setup and logins.
+	logins   uint64       // counts successful logins, so concurrent requests only log in again once.
 }
 
 // New returns a *starr.Config pointer. This pointer is safe to modify
@@ -0,0 +1,159 @@
+package starrhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/BSFishy/starr"
+)
+
+// DefaultMaxBodySize is the largest webhook body a Handler accepts when MaxBodySize is zero.
+const DefaultMaxBodySize = 10 << 20 // 10 MiB.
+
+// Callback receives a webhook event. The context is the incoming request's context.
+// Returning an error responds to the app with a 500, and the app may retry the webhook.
+type Callback func(ctx context.Context, event *HookEvent) error
+
+// Handler is an http.Handler that receives webhooks from Starr apps. Create one with New,
+// register callbacks with On and OnAny, then mount it on your HTTP server.
+// Set the exported members before serving requests.
+type Handler struct {
+	// App is the app that sends webhooks to this handler. If it is empty, the app is
+	// detected from the User-Agent header, which every Starr app sets to its own name.
+	App starr.App
+	// Username and Password enable basic authentication when either is not empty.
+	// Set them to the Username and Password configured on the webhook connection.
+	Username string
+	Password string
+	// MaxBodySize limits the size of a webhook body. Default is DefaultMaxBodySize.
+	MaxBodySize int64
+	// Errorf receives errors from parsing webhooks and from callbacks. They are otherwise discarded.
+	Errorf    func(string, ...interface{})
+	mu        sync.RWMutex
+	callbacks map[Event][]Callback
+	all       []Callback
+}
+
+// New returns a webhook handler for the provided app. Pass an empty app to detect it from each request.
+func New(app starr.App) *Handler {
+	return &Handler{App: app, callbacks: make(map[Event][]Callback)}
+}
+
+// On registers a callback for an event type. Callbacks run in the order they are registered.
+func (h *Handler) On(event Event, callback Callback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.callbacks == nil {
+		h.callbacks = make(map[Event][]Callback)
+	}
+
+	h.callbacks[event] = append(h.callbacks[event], callback)
+}
+
+// OnAny registers a callback for every event type. These run after the callbacks registered with On.
+func (h *Handler) OnAny(callback Callback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.all = append(h.all, callback)
+}
+
+// ServeHTTP satisfies the http.Handler interface.
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.Header().Set("Allow", http.MethodPost)
+		http.Error(resp, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if !h.authorized(req) {
+		resp.Header().Set("WWW-Authenticate", `Basic realm="starrhook"`)
+		http.Error(resp, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+		return
+	}
+
+	app := h.App
+	if app == "" {
+		if app = appFromUserAgent(req.UserAgent()); app == "" {
+			h.errorf("starrhook: %v: %s", ErrUnknownApp, req.UserAgent())
+			http.Error(resp, ErrUnknownApp.Error(), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	maxSize := h.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBodySize
+	}
+
+	event, err := Parse(app, http.MaxBytesReader(resp, req.Body, maxSize))
+	if err != nil {
+		h.errorf("starrhook: %s: %v", app, err)
+		http.Error(resp, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.dispatch(req.Context(), event); err != nil {
+		h.errorf("starrhook: %s %s: %v", app, event.Type, err)
+		http.Error(resp, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	resp.WriteHeader(http.StatusOK)
+}
+
+// dispatch runs every callback for the event, and stops at the first error.
+func (h *Handler) dispatch(ctx context.Context, event *HookEvent) error {
+	h.mu.RLock()
+	callbacks := append(append([]Callback{}, h.callbacks[event.Type]...), h.all...)
+	h.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		if err := callback(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorized returns true if basic auth is disabled, or the request has the right credentials.
+func (h *Handler) authorized(req *http.Request) bool {
+	if h.Username == "" && h.Password == "" {
+		return true
+	}
+
+	user, pass, ok := req.BasicAuth()
+	userOK := subtle.ConstantTimeCompare([]byte(user), []byte(h.Username)) == 1
+	passOK := subtle.ConstantTimeCompare([]byte(pass), []byte(h.Password)) == 1
+
+	return ok && userOK && passOK
+}
+
+// appFromUserAgent returns the app named in a User-Agent like "Sonarr/4.0.4.1491 (ubuntu 22.04)".
+func appFromUserAgent(userAgent string) starr.App {
+	name, _, _ := strings.Cut(userAgent, "/")
+
+	for _, app := range []starr.App{starr.Lidarr, starr.Prowlarr, starr.Radarr, starr.Readarr, starr.Sonarr} {
+		if strings.EqualFold(name, string(app)) {
+			return app
+		}
+	}
+
+	return ""
+}
+
+func (h *Handler) errorf(msg string, v ...interface{}) {
+	if h.Errorf != nil {
+		h.Errorf(msg, v...)
+	}
+}
@@ -0,0 +1,130 @@
+package starrhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/starrhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sonarrGrab = `{
+	"eventType": "Grab",
+	"instanceName": "Sonarr",
+	"applicationUrl": "http://sonarr:8989",
+	"series": {"id": 47, "title": "This Is Us", "tvdbId": 311714, "type": "standard"},
+	"episodes": [{"id": 123, "episodeNumber": 4, "seasonNumber": 6, "airDateUtc": "2022-01-26T02:00:00Z"}],
+	"release": {"quality": "HDTV-720p", "releaseTitle": "This.is.Us.S06E04.720p.HDTV.x264-SYNCOPY", "size": 885369406},
+	"downloadClient": "NZBGet",
+	"downloadId": "a87bda3c0e7f40a1b8fa011b421a5201",
+	"customFormatInfo": {"customFormats": [{"id": 1, "name": "x264"}], "customFormatScore": 10}
+}`
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan *starrhook.HookEvent, 1)
+
+	handler := starrhook.New("")
+	handler.Username, handler.Password = "user", "pass"
+	handler.On(starrhook.EventGrab, func(_ context.Context, event *starrhook.HookEvent) error {
+		events <- event
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Wrong credentials.
+	req := newRequest(t, server.URL, sonarrGrab)
+	req.SetBasicAuth("user", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, do(t, req))
+	assert.Empty(t, events, "callbacks must not run without valid credentials")
+
+	// Unknown app.
+	req = newRequest(t, server.URL, sonarrGrab)
+	req.SetBasicAuth("user", "pass")
+	req.Header.Set("User-Agent", "curl/8.0")
+	assert.Equal(t, http.StatusBadRequest, do(t, req))
+
+	req = newRequest(t, server.URL, sonarrGrab)
+	req.SetBasicAuth("user", "pass")
+	require.Equal(t, http.StatusOK, do(t, req))
+	require.Len(t, events, 1, "the grab callback must run")
+
+	got := <-events
+	assert.Equal(t, starr.Sonarr, got.App, "the app must be detected from the user agent")
+	assert.Equal(t, "Sonarr", got.InstanceName)
+
+	grab, err := got.GetSonarrGrab()
+	require.NoError(t, err)
+	assert.Equal(t, int64(47), grab.Series.ID)
+	assert.Equal(t, 6, grab.Episodes[0].SeasonNumber)
+	assert.Equal(t, 2022, grab.Episodes[0].AirDateUTC.Year())
+	assert.Equal(t, int64(885369406), grab.Release.Size)
+	assert.Equal(t, int64(10), grab.CustomFormatInfo.CustomFormatScore)
+
+	_, err = got.GetSonarrDownload()
+	require.ErrorIs(t, err, starrhook.ErrInvalidEvent)
+}
+
+func TestHandlerErrors(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	calls := atomic.Int32{}
+	handler := starrhook.New(starr.Radarr)
+	handler.OnAny(func(_ context.Context, event *starrhook.HookEvent) error {
+		calls.Add(1)
+
+		if event.Type == starrhook.EventHealthIssue {
+			return errTest
+		}
+
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req := newRequest(t, server.URL, `{"eventType":"Test"}`)
+	assert.Equal(t, http.StatusOK, do(t, req), "callbacks registered with OnAny must receive every event")
+
+	req = newRequest(t, server.URL, `{"eventType":"Health","level":"warning"}`)
+	assert.Equal(t, http.StatusInternalServerError, do(t, req), "a callback error must return a 500")
+
+	req = newRequest(t, server.URL, `{"level":"warning"}`)
+	assert.Equal(t, http.StatusBadRequest, do(t, req), "a payload without an event type must be rejected")
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, req))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func newRequest(t *testing.T, url, body string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("User-Agent", "Sonarr/4.0.4.1491 (ubuntu 22.04)")
+
+	return req
+}
+
+func do(t *testing.T, req *http.Request) int {
+	t.Helper()
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
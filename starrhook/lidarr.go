@@ -0,0 +1,176 @@
+package starrhook
+
+/*
+Webhook payloads from Lidarr v2.
+https://github.com/Lidarr/Lidarr/tree/develop/src/NzbDrone.Core/Notifications/Webhook
+*/
+
+import (
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+// LidarrArtist is the artist included with most Lidarr events.
+type LidarrArtist struct {
+	ID             int64          `json:"id"`   // 1
+	Name           string         `json:"name"` // Tool
+	Disambiguation string         `json:"disambiguation"`
+	Path           string         `json:"path"` // /music/Tool
+	MBID           string         `json:"mbId"` // 66fc5bf8-daa4-4241-b378-9bc9077939d2
+	Type           string         `json:"type"` // Group
+	Overview       string         `json:"overview"`
+	Genres         []string       `json:"genres"`
+	Images         []*starr.Image `json:"images"`
+	Tags           []string       `json:"tags"`
+}
+
+// LidarrAlbum is an album included with some Lidarr events.
+type LidarrAlbum struct {
+	ID             int64          `json:"id"`
+	MBID           string         `json:"mbId"`
+	Title          string         `json:"title"` // Fear Inoculum
+	Disambiguation string         `json:"disambiguation"`
+	Overview       string         `json:"overview"`
+	AlbumType      string         `json:"albumType"` // Album
+	ReleaseDate    time.Time      `json:"releaseDate"`
+	Genres         []string       `json:"genres"`
+	Images         []*starr.Image `json:"images"`
+}
+
+// LidarrTrack is a track included with the Download event.
+type LidarrTrack struct {
+	ID             int64  `json:"id"`
+	Title          string `json:"title"`
+	TrackNumber    string `json:"trackNumber"` // 1
+	Quality        string `json:"quality"`     // FLAC
+	QualityVersion int64  `json:"qualityVersion"`
+	ReleaseGroup   string `json:"releaseGroup"`
+}
+
+// LidarrTrackFile is a track file included with some Lidarr events.
+type LidarrTrackFile struct {
+	File
+}
+
+// LidarrRenamedTrackFile is a track file included with the Rename event.
+type LidarrRenamedTrackFile struct {
+	LidarrTrackFile
+	PreviousPath string `json:"previousPath"`
+}
+
+// LidarrGrab is the Grab event.
+type LidarrGrab struct {
+	Payload
+	Artist             *LidarrArtist  `json:"artist"`
+	Albums             []*LidarrAlbum `json:"albums"`
+	Release            *Release       `json:"release"`
+	DownloadClient     string         `json:"downloadClient"`
+	DownloadClientType string         `json:"downloadClientType"`
+	DownloadID         string         `json:"downloadId"`
+}
+
+// LidarrDownload is the Download event. This is AlbumDownload in starrcmd.
+type LidarrDownload struct {
+	Payload
+	Artist             *LidarrArtist      `json:"artist"`
+	Album              *LidarrAlbum       `json:"album"`
+	Tracks             []*LidarrTrack     `json:"tracks"`
+	TrackFiles         []*LidarrTrackFile `json:"trackFiles"`
+	DeletedFiles       []*LidarrTrackFile `json:"deletedFiles"` // Only present on upgrades.
+	IsUpgrade          bool               `json:"isUpgrade"`
+	DownloadClient     string             `json:"downloadClient"`
+	DownloadClientType string             `json:"downloadClientType"`
+	DownloadID         string             `json:"downloadId"`
+}
+
+// LidarrRename is the Rename event.
+type LidarrRename struct {
+	Payload
+	Artist            *LidarrArtist             `json:"artist"`
+	RenamedTrackFiles []*LidarrRenamedTrackFile `json:"renamedTrackFiles"`
+}
+
+// LidarrTrackRetag is the Retag event.
+type LidarrTrackRetag struct {
+	Payload
+	Artist    *LidarrArtist    `json:"artist"`
+	TrackFile *LidarrTrackFile `json:"trackFile"`
+}
+
+// LidarrArtistAdd is the ArtistAdd event.
+type LidarrArtistAdd struct {
+	Payload
+	Artist *LidarrArtist `json:"artist"`
+}
+
+// LidarrArtistDelete is the ArtistDelete event.
+type LidarrArtistDelete struct {
+	Payload
+	Artist       *LidarrArtist `json:"artist"`
+	DeletedFiles bool          `json:"deletedFiles"`
+}
+
+// LidarrAlbumDelete is the AlbumDelete event.
+type LidarrAlbumDelete struct {
+	Payload
+	Album        *LidarrAlbum `json:"album"`
+	DeletedFiles bool         `json:"deletedFiles"`
+}
+
+// LidarrTest is the Test event. It contains fake data.
+type LidarrTest struct {
+	Payload
+	Artist *LidarrArtist  `json:"artist"`
+	Albums []*LidarrAlbum `json:"albums"`
+}
+
+// GetLidarrApplicationUpdate returns the ApplicationUpdate event data.
+func (e *HookEvent) GetLidarrApplicationUpdate() (output ApplicationUpdate, err error) {
+	return output, e.get(&output, EventApplicationUpdate)
+}
+
+// GetLidarrHealthIssue returns the Health or HealthRestored event data.
+func (e *HookEvent) GetLidarrHealthIssue() (output HealthIssue, err error) {
+	return output, e.get(&output, EventHealthIssue, EventHealthRestored)
+}
+
+// GetLidarrGrab returns the Grab event data.
+func (e *HookEvent) GetLidarrGrab() (output LidarrGrab, err error) {
+	return output, e.get(&output, EventGrab)
+}
+
+// GetLidarrDownload returns the Download event data.
+func (e *HookEvent) GetLidarrDownload() (output LidarrDownload, err error) {
+	return output, e.get(&output, EventDownload)
+}
+
+// GetLidarrRename returns the Rename event data.
+func (e *HookEvent) GetLidarrRename() (output LidarrRename, err error) {
+	return output, e.get(&output, EventRename)
+}
+
+// GetLidarrTrackRetag returns the Retag event data.
+func (e *HookEvent) GetLidarrTrackRetag() (output LidarrTrackRetag, err error) {
+	return output, e.get(&output, EventTrackRetag)
+}
+
+// GetLidarrArtistAdd returns the ArtistAdd event data.
+func (e *HookEvent) GetLidarrArtistAdd() (output LidarrArtistAdd, err error) {
+	return output, e.get(&output, EventArtistAdd)
+}
+
+// GetLidarrArtistDelete returns the ArtistDelete event data.
+func (e *HookEvent) GetLidarrArtistDelete() (output LidarrArtistDelete, err error) {
+	return output, e.get(&output, EventArtistDelete)
+}
+
+// GetLidarrAlbumDelete returns the AlbumDelete event data.
+func (e *HookEvent) GetLidarrAlbumDelete() (output LidarrAlbumDelete, err error) {
+	return output, e.get(&output, EventAlbumDelete)
+}
+
+// GetLidarrTest returns the Test event data.
+func (e *HookEvent) GetLidarrTest() (output LidarrTest, err error) {
+	return output, e.get(&output, EventTest)
+}
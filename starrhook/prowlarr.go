@@ -0,0 +1,56 @@
+package starrhook
+
+/*
+Webhook payloads from Prowlarr v1.
+https://github.com/Prowlarr/Prowlarr/tree/develop/src/NzbDrone.Core/Notifications/Webhook
+*/
+
+import (
+	"time"
+)
+
+// ProwlarrRelease is the release included with the Grab event.
+type ProwlarrRelease struct {
+	ReleaseTitle string    `json:"releaseTitle"`
+	Indexer      string    `json:"indexer"`
+	Size         int64     `json:"size"`
+	Categories   []string  `json:"categories"`
+	Genres       []string  `json:"genres"`
+	IndexerFlags []string  `json:"indexerFlags"`
+	PublishDate  time.Time `json:"publishDate"`
+}
+
+// ProwlarrGrab is the Grab event.
+type ProwlarrGrab struct {
+	Payload
+	Release  *ProwlarrRelease `json:"release"`
+	Trigger  string           `json:"trigger"` // Manual
+	Source   string           `json:"source"`  // Prowlarr
+	Host     string           `json:"host"`
+	Redirect bool             `json:"redirect"`
+}
+
+// ProwlarrTest is the Test event.
+type ProwlarrTest struct {
+	Payload
+}
+
+// GetProwlarrApplicationUpdate returns the ApplicationUpdate event data.
+func (e *HookEvent) GetProwlarrApplicationUpdate() (output ApplicationUpdate, err error) {
+	return output, e.get(&output, EventApplicationUpdate)
+}
+
+// GetProwlarrHealthIssue returns the Health or HealthRestored event data.
+func (e *HookEvent) GetProwlarrHealthIssue() (output HealthIssue, err error) {
+	return output, e.get(&output, EventHealthIssue, EventHealthRestored)
+}
+
+// GetProwlarrGrab returns the Grab event data.
+func (e *HookEvent) GetProwlarrGrab() (output ProwlarrGrab, err error) {
+	return output, e.get(&output, EventGrab)
+}
+
+// GetProwlarrTest returns the Test event data.
+func (e *HookEvent) GetProwlarrTest() (output ProwlarrTest, err error) {
+	return output, e.get(&output, EventTest)
+}
@@ -0,0 +1,179 @@
+package starrhook
+
+/*
+Webhook payloads from Radarr v5.
+https://github.com/Radarr/Radarr/tree/develop/src/NzbDrone.Core/Notifications/Webhook
+*/
+
+import (
+	"github.com/BSFishy/starr"
+)
+
+// RadarrMovie is the movie included with most Radarr events.
+type RadarrMovie struct {
+	ID               int64          `json:"id"`          // 924
+	Title            string         `json:"title"`       // Just Go with It
+	Year             int            `json:"year"`        // 2011
+	ReleaseDate      string         `json:"releaseDate"` // 2011-06-07
+	FolderPath       string         `json:"folderPath"`  // /movies/Just Go with It (2011)
+	TMDbID           int64          `json:"tmdbId"`      // 50546
+	IMDbID           string         `json:"imdbId"`      // tt1564367
+	Overview         string         `json:"overview"`
+	Genres           []string       `json:"genres"`
+	Images           []*starr.Image `json:"images"`
+	Tags             []string       `json:"tags"`
+	OriginalLanguage *starr.Value   `json:"originalLanguage"`
+}
+
+// RadarrRemoteMovie is the movie parsed from a release title.
+type RadarrRemoteMovie struct {
+	TMDbID int64  `json:"tmdbId"`
+	IMDbID string `json:"imdbId"`
+	Title  string `json:"title"`
+	Year   int    `json:"year"`
+}
+
+// RadarrMovieFile is a movie file included with some Radarr events.
+type RadarrMovieFile struct {
+	File
+	RelativePath   string `json:"relativePath"` // Just.Go.with.It.2011.Bluray-1080p.mkv
+	IndexerFlags   string `json:"indexerFlags"`
+	SourcePath     string `json:"sourcePath,omitempty"`
+	RecycleBinPath string `json:"recycleBinPath,omitempty"`
+}
+
+// RadarrRenamedMovieFile is a movie file included with the Rename event.
+type RadarrRenamedMovieFile struct {
+	RadarrMovieFile
+	PreviousRelativePath string `json:"previousRelativePath"`
+	PreviousPath         string `json:"previousPath"`
+}
+
+// RadarrGrab is the Grab event.
+type RadarrGrab struct {
+	Payload
+	Movie              *RadarrMovie       `json:"movie"`
+	RemoteMovie        *RadarrRemoteMovie `json:"remoteMovie"`
+	Release            *Release           `json:"release"`
+	DownloadClient     string             `json:"downloadClient"`     // Deluge
+	DownloadClientType string             `json:"downloadClientType"` // Deluge
+	DownloadID         string             `json:"downloadId"`         // E63FAFFAAA0DEE42F0846348A9C0657BC53E7AA5
+	CustomFormatInfo   *CustomFormatInfo  `json:"customFormatInfo"`
+}
+
+// RadarrDownload is the Download event.
+type RadarrDownload struct {
+	Payload
+	Movie              *RadarrMovie       `json:"movie"`
+	RemoteMovie        *RadarrRemoteMovie `json:"remoteMovie"`
+	MovieFile          *RadarrMovieFile   `json:"movieFile"`
+	IsUpgrade          bool               `json:"isUpgrade"`
+	DownloadClient     string             `json:"downloadClient"`
+	DownloadClientType string             `json:"downloadClientType"`
+	DownloadID         string             `json:"downloadId"`
+	DeletedFiles       []*RadarrMovieFile `json:"deletedFiles"` // Only present on upgrades.
+	CustomFormatInfo   *CustomFormatInfo  `json:"customFormatInfo"`
+	Release            *Release           `json:"release"`
+}
+
+// RadarrRename is the Rename event.
+type RadarrRename struct {
+	Payload
+	Movie             *RadarrMovie              `json:"movie"`
+	RenamedMovieFiles []*RadarrRenamedMovieFile `json:"renamedMovieFiles"`
+}
+
+// RadarrMovieAdded is the MovieAdded event.
+type RadarrMovieAdded struct {
+	Payload
+	Movie     *RadarrMovie `json:"movie"`
+	AddMethod string       `json:"addMethod"` // Manual
+}
+
+// RadarrMovieDelete is the MovieDelete event.
+type RadarrMovieDelete struct {
+	Payload
+	Movie           *RadarrMovie `json:"movie"`
+	DeletedFiles    bool         `json:"deletedFiles"`
+	MovieFolderSize int64        `json:"movieFolderSize"`
+}
+
+// RadarrMovieFileDelete is the MovieFileDelete event.
+type RadarrMovieFileDelete struct {
+	Payload
+	Movie        *RadarrMovie     `json:"movie"`
+	MovieFile    *RadarrMovieFile `json:"movieFile"`
+	DeleteReason string           `json:"deleteReason"` // Upgrade
+}
+
+// RadarrManualInteractionRequired is the ManualInteractionRequired event.
+type RadarrManualInteractionRequired struct {
+	Payload
+	Movie                  *RadarrMovie           `json:"movie"`
+	DownloadInfo           *DownloadInfo          `json:"downloadInfo"`
+	DownloadClient         string                 `json:"downloadClient"`
+	DownloadClientType     string                 `json:"downloadClientType"`
+	DownloadID             string                 `json:"downloadId"`
+	DownloadStatus         string                 `json:"downloadStatus"`
+	DownloadStatusMessages []*starr.StatusMessage `json:"downloadStatusMessages"`
+	CustomFormatInfo       *CustomFormatInfo      `json:"customFormatInfo"`
+	Release                *Release               `json:"release"`
+}
+
+// RadarrTest is the Test event. It contains fake data.
+type RadarrTest struct {
+	Payload
+	Movie       *RadarrMovie       `json:"movie"`
+	RemoteMovie *RadarrRemoteMovie `json:"remoteMovie"`
+	Release     *Release           `json:"release"`
+}
+
+// GetRadarrApplicationUpdate returns the ApplicationUpdate event data.
+func (e *HookEvent) GetRadarrApplicationUpdate() (output ApplicationUpdate, err error) {
+	return output, e.get(&output, EventApplicationUpdate)
+}
+
+// GetRadarrHealthIssue returns the Health or HealthRestored event data.
+func (e *HookEvent) GetRadarrHealthIssue() (output HealthIssue, err error) {
+	return output, e.get(&output, EventHealthIssue, EventHealthRestored)
+}
+
+// GetRadarrGrab returns the Grab event data.
+func (e *HookEvent) GetRadarrGrab() (output RadarrGrab, err error) {
+	return output, e.get(&output, EventGrab)
+}
+
+// GetRadarrDownload returns the Download event data.
+func (e *HookEvent) GetRadarrDownload() (output RadarrDownload, err error) {
+	return output, e.get(&output, EventDownload)
+}
+
+// GetRadarrRename returns the Rename event data.
+func (e *HookEvent) GetRadarrRename() (output RadarrRename, err error) {
+	return output, e.get(&output, EventRename)
+}
+
+// GetRadarrMovieAdded returns the MovieAdded event data.
+func (e *HookEvent) GetRadarrMovieAdded() (output RadarrMovieAdded, err error) {
+	return output, e.get(&output, EventMovieAdded)
+}
+
+// GetRadarrMovieDelete returns the MovieDelete event data.
+func (e *HookEvent) GetRadarrMovieDelete() (output RadarrMovieDelete, err error) {
+	return output, e.get(&output, EventMovieDelete)
+}
+
+// GetRadarrMovieFileDelete returns the MovieFileDelete event data.
+func (e *HookEvent) GetRadarrMovieFileDelete() (output RadarrMovieFileDelete, err error) {
+	return output, e.get(&output, EventMovieFileDelete)
+}
+
+// GetRadarrManualInteractionRequired returns the ManualInteractionRequired event data.
+func (e *HookEvent) GetRadarrManualInteractionRequired() (output RadarrManualInteractionRequired, err error) {
+	return output, e.get(&output, EventManualInteractionRequired)
+}
+
+// GetRadarrTest returns the Test event data.
+func (e *HookEvent) GetRadarrTest() (output RadarrTest, err error) {
+	return output, e.get(&output, EventTest)
+}
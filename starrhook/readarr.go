@@ -0,0 +1,167 @@
+package starrhook
+
+/*
+Webhook payloads from Readarr v0.
+https://github.com/Readarr/Readarr/tree/develop/src/NzbDrone.Core/Notifications/Webhook
+*/
+
+import (
+	"time"
+)
+
+// ReadarrAuthor is the author included with most Readarr events.
+type ReadarrAuthor struct {
+	ID          int64  `json:"id"`          // 1
+	Name        string `json:"name"`        // Stephen King
+	Path        string `json:"path"`        // /books/Stephen King
+	GoodreadsID string `json:"goodreadsId"` // 3389
+}
+
+// ReadarrBook is a book included with some Readarr events.
+type ReadarrBook struct {
+	ID          int64     `json:"id"`
+	GoodreadsID string    `json:"goodreadsId"`
+	Title       string    `json:"title"` // The Stand
+	ReleaseDate time.Time `json:"releaseDate"`
+}
+
+// ReadarrBookFile is a book file included with some Readarr events.
+type ReadarrBookFile struct {
+	File
+}
+
+// ReadarrRenamedBookFile is a book file included with the Rename event.
+type ReadarrRenamedBookFile struct {
+	ReadarrBookFile
+	PreviousPath string `json:"previousPath"`
+}
+
+// ReadarrGrab is the Grab event.
+type ReadarrGrab struct {
+	Payload
+	Author             *ReadarrAuthor `json:"author"`
+	Books              []*ReadarrBook `json:"books"`
+	Release            *Release       `json:"release"`
+	DownloadClient     string         `json:"downloadClient"`
+	DownloadClientType string         `json:"downloadClientType"`
+	DownloadID         string         `json:"downloadId"`
+}
+
+// ReadarrDownload is the Download event.
+type ReadarrDownload struct {
+	Payload
+	Author             *ReadarrAuthor     `json:"author"`
+	Book               *ReadarrBook       `json:"book"`
+	BookFiles          []*ReadarrBookFile `json:"bookFiles"`
+	DeletedFiles       []*ReadarrBookFile `json:"deletedFiles"` // Only present on upgrades.
+	IsUpgrade          bool               `json:"isUpgrade"`
+	DownloadClient     string             `json:"downloadClient"`
+	DownloadClientType string             `json:"downloadClientType"`
+	DownloadID         string             `json:"downloadId"`
+}
+
+// ReadarrRename is the Rename event.
+type ReadarrRename struct {
+	Payload
+	Author           *ReadarrAuthor            `json:"author"`
+	RenamedBookFiles []*ReadarrRenamedBookFile `json:"renamedBookFiles"`
+}
+
+// ReadarrTrackRetag is the Retag event.
+type ReadarrTrackRetag struct {
+	Payload
+	Author   *ReadarrAuthor   `json:"author"`
+	BookFile *ReadarrBookFile `json:"bookFile"`
+}
+
+// ReadarrAuthorAdded is the AuthorAdded event.
+type ReadarrAuthorAdded struct {
+	Payload
+	Author *ReadarrAuthor `json:"author"`
+}
+
+// ReadarrAuthorDelete is the AuthorDelete event.
+type ReadarrAuthorDelete struct {
+	Payload
+	Author       *ReadarrAuthor `json:"author"`
+	DeletedFiles bool           `json:"deletedFiles"`
+}
+
+// ReadarrBookDelete is the BookDelete event.
+type ReadarrBookDelete struct {
+	Payload
+	Author       *ReadarrAuthor `json:"author"`
+	Book         *ReadarrBook   `json:"book"`
+	DeletedFiles bool           `json:"deletedFiles"`
+}
+
+// ReadarrBookFileDelete is the BookFileDelete event.
+type ReadarrBookFileDelete struct {
+	Payload
+	Author       *ReadarrAuthor   `json:"author"`
+	Book         *ReadarrBook     `json:"book"`
+	BookFile     *ReadarrBookFile `json:"bookFile"`
+	DeleteReason string           `json:"deleteReason"` // Upgrade
+}
+
+// ReadarrTest is the Test event. It contains fake data.
+type ReadarrTest struct {
+	Payload
+	Author *ReadarrAuthor `json:"author"`
+	Books  []*ReadarrBook `json:"books"`
+}
+
+// GetReadarrApplicationUpdate returns the ApplicationUpdate event data.
+func (e *HookEvent) GetReadarrApplicationUpdate() (output ApplicationUpdate, err error) {
+	return output, e.get(&output, EventApplicationUpdate)
+}
+
+// GetReadarrHealthIssue returns the Health or HealthRestored event data.
+func (e *HookEvent) GetReadarrHealthIssue() (output HealthIssue, err error) {
+	return output, e.get(&output, EventHealthIssue, EventHealthRestored)
+}
+
+// GetReadarrGrab returns the Grab event data.
+func (e *HookEvent) GetReadarrGrab() (output ReadarrGrab, err error) {
+	return output, e.get(&output, EventGrab)
+}
+
+// GetReadarrDownload returns the Download event data.
+func (e *HookEvent) GetReadarrDownload() (output ReadarrDownload, err error) {
+	return output, e.get(&output, EventDownload)
+}
+
+// GetReadarrRename returns the Rename event data.
+func (e *HookEvent) GetReadarrRename() (output ReadarrRename, err error) {
+	return output, e.get(&output, EventRename)
+}
+
+// GetReadarrTrackRetag returns the Retag event data.
+func (e *HookEvent) GetReadarrTrackRetag() (output ReadarrTrackRetag, err error) {
+	return output, e.get(&output, EventTrackRetag)
+}
+
+// GetReadarrAuthorAdded returns the AuthorAdded event data.
+func (e *HookEvent) GetReadarrAuthorAdded() (output ReadarrAuthorAdded, err error) {
+	return output, e.get(&output, EventAuthorAdded)
+}
+
+// GetReadarrAuthorDelete returns the AuthorDelete event data.
+func (e *HookEvent) GetReadarrAuthorDelete() (output ReadarrAuthorDelete, err error) {
+	return output, e.get(&output, EventAuthorDelete)
+}
+
+// GetReadarrBookDelete returns the BookDelete event data.
+func (e *HookEvent) GetReadarrBookDelete() (output ReadarrBookDelete, err error) {
+	return output, e.get(&output, EventBookDelete)
+}
+
+// GetReadarrBookFileDelete returns the BookFileDelete event data.
+func (e *HookEvent) GetReadarrBookFileDelete() (output ReadarrBookFileDelete, err error) {
+	return output, e.get(&output, EventBookFileDelete)
+}
+
+// GetReadarrTest returns the Test event data.
+func (e *HookEvent) GetReadarrTest() (output ReadarrTest, err error) {
+	return output, e.get(&output, EventTest)
+}
@@ -0,0 +1,185 @@
+package starrhook
+
+/*
+Webhook payloads from Sonarr v4.
+https://github.com/Sonarr/Sonarr/tree/develop/src/NzbDrone.Core/Notifications/Webhook
+*/
+
+import (
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+// SonarrSeries is the series included with most Sonarr events.
+type SonarrSeries struct {
+	ID        int64          `json:"id"`        // 47
+	Title     string         `json:"title"`     // This Is Us
+	TitleSlug string         `json:"titleSlug"` // this-is-us
+	Path      string         `json:"path"`      // /tv/This Is Us
+	TVDbID    int64          `json:"tvdbId"`    // 311714
+	TVMazeID  int64          `json:"tvMazeId"`  // 17128
+	TMDbID    int64          `json:"tmdbId"`    // 67136
+	IMDbID    string         `json:"imdbId"`    // tt5555260
+	Type      string         `json:"type"`      // Standard
+	Year      int            `json:"year"`      // 2016
+	Genres    []string       `json:"genres"`
+	Images    []*starr.Image `json:"images"`
+	Tags      []string       `json:"tags"`
+}
+
+// SonarrEpisode is an episode included with some Sonarr events.
+type SonarrEpisode struct {
+	ID            int64     `json:"id"`            // 22691
+	EpisodeNumber int       `json:"episodeNumber"` // 4
+	SeasonNumber  int       `json:"seasonNumber"`  // 6
+	Title         string    `json:"title"`         // Don't Let Me Keep You
+	Overview      string    `json:"overview"`
+	AirDate       string    `json:"airDate"`    // 2022-01-25
+	AirDateUTC    time.Time `json:"airDateUtc"` // 2022-01-26T02:00:00Z
+	SeriesID      int64     `json:"seriesId"`   // 47
+	TVDbID        int64     `json:"tvdbId"`
+}
+
+// SonarrEpisodeFile is an episode file included with some Sonarr events.
+type SonarrEpisodeFile struct {
+	File
+	RelativePath   string `json:"relativePath"` // Season 5/Puppy Dog Pals - S05E03-04.mkv
+	SourcePath     string `json:"sourcePath,omitempty"`
+	RecycleBinPath string `json:"recycleBinPath,omitempty"`
+}
+
+// SonarrRenamedEpisodeFile is an episode file included with the Rename event.
+type SonarrRenamedEpisodeFile struct {
+	SonarrEpisodeFile
+	PreviousRelativePath string `json:"previousRelativePath"`
+	PreviousPath         string `json:"previousPath"`
+}
+
+// SonarrGrab is the Grab event.
+type SonarrGrab struct {
+	Payload
+	Series             *SonarrSeries     `json:"series"`
+	Episodes           []*SonarrEpisode  `json:"episodes"`
+	Release            *Release          `json:"release"`
+	DownloadClient     string            `json:"downloadClient"`     // NZBGet
+	DownloadClientType string            `json:"downloadClientType"` // Nzbget
+	DownloadID         string            `json:"downloadId"`         // a87bda3c0e7f40a1b8fa011b421a5201
+	CustomFormatInfo   *CustomFormatInfo `json:"customFormatInfo"`
+}
+
+// SonarrDownload is the Download event.
+type SonarrDownload struct {
+	Payload
+	Series             *SonarrSeries        `json:"series"`
+	Episodes           []*SonarrEpisode     `json:"episodes"`
+	EpisodeFile        *SonarrEpisodeFile   `json:"episodeFile"`
+	IsUpgrade          bool                 `json:"isUpgrade"`
+	DownloadClient     string               `json:"downloadClient"`
+	DownloadClientType string               `json:"downloadClientType"`
+	DownloadID         string               `json:"downloadId"`
+	DeletedFiles       []*SonarrEpisodeFile `json:"deletedFiles"` // Only present on upgrades.
+	CustomFormatInfo   *CustomFormatInfo    `json:"customFormatInfo"`
+	Release            *Release             `json:"release"`
+}
+
+// SonarrRename is the Rename event.
+type SonarrRename struct {
+	Payload
+	Series              *SonarrSeries               `json:"series"`
+	RenamedEpisodeFiles []*SonarrRenamedEpisodeFile `json:"renamedEpisodeFiles"`
+}
+
+// SonarrSeriesAdd is the SeriesAdd event.
+type SonarrSeriesAdd struct {
+	Payload
+	Series *SonarrSeries `json:"series"`
+}
+
+// SonarrSeriesDelete is the SeriesDelete event.
+type SonarrSeriesDelete struct {
+	Payload
+	Series       *SonarrSeries `json:"series"`
+	DeletedFiles bool          `json:"deletedFiles"`
+}
+
+// SonarrEpisodeFileDelete is the EpisodeFileDelete event.
+type SonarrEpisodeFileDelete struct {
+	Payload
+	Series       *SonarrSeries      `json:"series"`
+	Episodes     []*SonarrEpisode   `json:"episodes"`
+	EpisodeFile  *SonarrEpisodeFile `json:"episodeFile"`
+	DeleteReason string             `json:"deleteReason"` // Upgrade
+}
+
+// SonarrManualInteractionRequired is the ManualInteractionRequired event.
+type SonarrManualInteractionRequired struct {
+	Payload
+	Series                 *SonarrSeries          `json:"series"`
+	Episodes               []*SonarrEpisode       `json:"episodes"`
+	DownloadInfo           *DownloadInfo          `json:"downloadInfo"`
+	DownloadClient         string                 `json:"downloadClient"`
+	DownloadClientType     string                 `json:"downloadClientType"`
+	DownloadID             string                 `json:"downloadId"`
+	DownloadStatus         string                 `json:"downloadStatus"`
+	DownloadStatusMessages []*starr.StatusMessage `json:"downloadStatusMessages"`
+	CustomFormatInfo       *CustomFormatInfo      `json:"customFormatInfo"`
+	Release                *Release               `json:"release"`
+}
+
+// SonarrTest is the Test event. It contains fake data.
+type SonarrTest struct {
+	Payload
+	Series   *SonarrSeries    `json:"series"`
+	Episodes []*SonarrEpisode `json:"episodes"`
+}
+
+// GetSonarrApplicationUpdate returns the ApplicationUpdate event data.
+func (e *HookEvent) GetSonarrApplicationUpdate() (output ApplicationUpdate, err error) {
+	return output, e.get(&output, EventApplicationUpdate)
+}
+
+// GetSonarrHealthIssue returns the Health or HealthRestored event data.
+func (e *HookEvent) GetSonarrHealthIssue() (output HealthIssue, err error) {
+	return output, e.get(&output, EventHealthIssue, EventHealthRestored)
+}
+
+// GetSonarrGrab returns the Grab event data.
+func (e *HookEvent) GetSonarrGrab() (output SonarrGrab, err error) {
+	return output, e.get(&output, EventGrab)
+}
+
+// GetSonarrDownload returns the Download event data.
+func (e *HookEvent) GetSonarrDownload() (output SonarrDownload, err error) {
+	return output, e.get(&output, EventDownload)
+}
+
+// GetSonarrRename returns the Rename event data.
+func (e *HookEvent) GetSonarrRename() (output SonarrRename, err error) {
+	return output, e.get(&output, EventRename)
+}
+
+// GetSonarrSeriesAdd returns the SeriesAdd event data.
+func (e *HookEvent) GetSonarrSeriesAdd() (output SonarrSeriesAdd, err error) {
+	return output, e.get(&output, EventSeriesAdd)
+}
+
+// GetSonarrSeriesDelete returns the SeriesDelete event data.
+func (e *HookEvent) GetSonarrSeriesDelete() (output SonarrSeriesDelete, err error) {
+	return output, e.get(&output, EventSeriesDelete)
+}
+
+// GetSonarrEpisodeFileDelete returns the EpisodeFileDelete event data.
+func (e *HookEvent) GetSonarrEpisodeFileDelete() (output SonarrEpisodeFileDelete, err error) {
+	return output, e.get(&output, EventEpisodeFileDelete)
+}
+
+// GetSonarrManualInteractionRequired returns the ManualInteractionRequired event data.
+func (e *HookEvent) GetSonarrManualInteractionRequired() (output SonarrManualInteractionRequired, err error) {
+	return output, e.get(&output, EventManualInteractionRequired)
+}
+
+// GetSonarrTest returns the Test event data.
+func (e *HookEvent) GetSonarrTest() (output SonarrTest, err error) {
+	return output, e.get(&output, EventTest)
+}
@@ -0,0 +1,176 @@
+// Package starrhook provides the bindings to consume a webhook from any Starr app.
+// Create these by going into Settings->Connect->Webhook in Lidarr, Prowlarr, Radarr, Readarr, or Sonarr.
+// This is the webhook sibling of the starrcmd package; the event names and the
+// Get<App><Event>() methods mirror that package, but the data comes from a JSON POST body.
+// Use Handler to receive webhooks over HTTP, or Parse to decode a payload you already have.
+package starrhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/BSFishy/starr"
+)
+
+var (
+	// ErrInvalidEvent is returned if you invoke a procedure for the wrong event.
+	ErrInvalidEvent = errors.New("incorrect event type requested")
+	// ErrNoEventFound is returned if a payload has no eventType.
+	ErrNoEventFound = errors.New("no eventType found in webhook payload")
+	// ErrUnknownApp is returned when the app that sent a webhook cannot be determined.
+	ErrUnknownApp = errors.New("unable to determine which app sent the webhook")
+)
+
+// Event is a custom type to hold our EventType.
+type Event string
+
+// This list of constants represents the webhook Event Types for all five Starr apps.
+// The names match the starrcmd package, but the values are what the webhook sends.
+// The webhook calls a health issue "Health" and a retag "Retag".
+// Lidarr's starrcmd AlbumDownload event is a Download event in a webhook.
+const (
+	EventTest                      Event = "Test"                      // All Apps, useless
+	EventHealthIssue               Event = "Health"                    // All Apps
+	EventHealthRestored            Event = "HealthRestored"            // All Apps
+	EventApplicationUpdate         Event = "ApplicationUpdate"         // All Apps
+	EventGrab                      Event = "Grab"                      // All Apps
+	EventRename                    Event = "Rename"                    // All Apps except Prowlarr
+	EventDownload                  Event = "Download"                  // All Apps except Prowlarr
+	EventManualInteractionRequired Event = "ManualInteractionRequired" // Radarr & Sonarr
+	EventTrackRetag                Event = "Retag"                     // Lidarr & Readarr
+	EventArtistAdd                 Event = "ArtistAdd"                 // Lidarr
+	EventArtistDelete              Event = "ArtistDelete"              // Lidarr
+	EventAlbumDelete               Event = "AlbumDelete"               // Lidarr
+	EventMovieAdded                Event = "MovieAdded"                // Radarr
+	EventMovieFileDelete           Event = "MovieFileDelete"           // Radarr
+	EventMovieDelete               Event = "MovieDelete"               // Radarr
+	EventAuthorAdded               Event = "AuthorAdded"               // Readarr
+	EventBookDelete                Event = "BookDelete"                // Readarr
+	EventAuthorDelete              Event = "AuthorDelete"              // Readarr
+	EventBookFileDelete            Event = "BookFileDelete"            // Readarr
+	EventSeriesAdd                 Event = "SeriesAdd"                 // Sonarr
+	EventSeriesDelete              Event = "SeriesDelete"              // Sonarr
+	EventEpisodeFileDelete         Event = "EpisodeFileDelete"         // Sonarr
+)
+
+// HookEvent holds a webhook payload, its event type and the app that sent it.
+// Get one of these by calling Parse, or from a Handler callback.
+type HookEvent struct {
+	App  starr.App
+	Type Event
+	// InstanceName is the name given to the app in its general settings.
+	InstanceName   string
+	ApplicationURL string
+	// Body is the raw payload. Use one of the Get methods to decode it.
+	Body json.RawMessage
+}
+
+// Payload contains the members included in every webhook payload.
+// It is embedded in every other payload.
+type Payload struct {
+	EventType      Event  `json:"eventType"`
+	InstanceName   string `json:"instanceName"`
+	ApplicationURL string `json:"applicationUrl"`
+}
+
+// HealthIssue is the Health and HealthRestored event in every app.
+type HealthIssue struct {
+	Payload
+	Level   string `json:"level"`   // Warning
+	Message string `json:"message"` // Lists unavailable due to failures: Listnamehere
+	Type    string `json:"type"`    // ImportListStatusCheck
+	WikiURL string `json:"wikiUrl"` // https://wiki.servarr.com/
+}
+
+// ApplicationUpdate is the ApplicationUpdate event in every app.
+type ApplicationUpdate struct {
+	Payload
+	Message         string `json:"message"`         // Sonarr updated from 4.0.3.5875 to 4.0.4.5909
+	PreviousVersion string `json:"previousVersion"` // 4.0.3.5875
+	NewVersion      string `json:"newVersion"`      // 4.0.4.5909
+}
+
+// Release is the release that was grabbed. Not every app sends every member.
+type Release struct {
+	Quality           string   `json:"quality"`        // HDTV-720p
+	QualityVersion    int64    `json:"qualityVersion"` // 1
+	ReleaseGroup      string   `json:"releaseGroup"`   // SYNCOPY
+	ReleaseTitle      string   `json:"releaseTitle"`   // This.is.Us.S06E04.720p.HDTV.x264-SYNCOPY
+	Indexer           string   `json:"indexer"`        // Indexor (Prowlarr)
+	Size              int64    `json:"size"`           // 885369406
+	CustomFormatScore int64    `json:"customFormatScore,omitempty"`
+	CustomFormats     []string `json:"customFormats,omitempty"`
+}
+
+// CustomFormatInfo lists the custom formats that matched a release, and their combined score.
+type CustomFormatInfo struct {
+	CustomFormats     []*starr.Value `json:"customFormats"`
+	CustomFormatScore int64          `json:"customFormatScore"`
+}
+
+// DownloadInfo is the download that needs manual interaction.
+type DownloadInfo struct {
+	Quality        string `json:"quality"`
+	QualityVersion int64  `json:"qualityVersion"`
+	Title          string `json:"title"`
+	Size           int64  `json:"size"`
+}
+
+// File contains the members every app includes with a media file.
+type File struct {
+	ID             int64     `json:"id"`
+	Path           string    `json:"path"`
+	Quality        string    `json:"quality"`
+	QualityVersion int64     `json:"qualityVersion"`
+	ReleaseGroup   string    `json:"releaseGroup"`
+	SceneName      string    `json:"sceneName"`
+	Size           int64     `json:"size"`
+	DateAdded      time.Time `json:"dateAdded"`
+}
+
+// Parse decodes a webhook payload from the provided app.
+// The app is not validated; it is only stored on the returned event.
+func Parse(app starr.App, body io.Reader) (*HookEvent, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("reading webhook body: %w", err)
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("decoding webhook payload: %w", err)
+	}
+
+	if payload.EventType == "" {
+		return nil, ErrNoEventFound
+	}
+
+	return &HookEvent{
+		App:            app,
+		Type:           payload.EventType,
+		InstanceName:   payload.InstanceName,
+		ApplicationURL: payload.ApplicationURL,
+		Body:           bytes.TrimSpace(data),
+	}, nil
+}
+
+// get offloads the error checking from all the other routines.
+func (e *HookEvent) get(output interface{}, wanted ...Event) error {
+	for _, event := range wanted {
+		if e.Type != event {
+			continue
+		}
+
+		if err := json.Unmarshal(e.Body, output); err != nil {
+			return fmt.Errorf("decoding %s payload: %w", e.Type, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: requested '%s' have '%s'", ErrInvalidEvent, wanted[0], e.Type)
+}
@@ -0,0 +1,443 @@
+package starrtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/* The fakes in this file keep JSON objects in memory, so they do not depend on the app packages. */
+
+// Default values used by the fake servers.
+const (
+	DefaultFakeVersion  = "4.0.0.0"
+	DefaultFakePageSize = 10
+)
+
+// Errors returned by the fake servers.
+var (
+	ErrNoCollection = errors.New("fake has no such collection")
+	ErrNoBody       = errors.New("request has no body")
+)
+
+// Fake is an in-memory Starr app for integration-style tests.
+// Create one with NewFakeSonarr or NewFakeRadarr, and Close it when done.
+// Objects are stored as JSON objects in collections named after their API path, like "series" or "tag".
+// Each collection supports list, get, create, update and delete with the same paths and methods as the app.
+type Fake struct {
+	*httptest.Server
+	// APIKey must be sent with every request. Requests without it get a 401.
+	APIKey string
+	// AppName and Version are returned from the system/status endpoint.
+	AppName string
+	Version string
+
+	prefix      string
+	mu          sync.Mutex
+	collections map[string]*collection
+	routes      map[string]fakeRoute
+}
+
+// FakeObject is a stored object. Numbers are float64, like encoding/json decodes them.
+type FakeObject map[string]interface{}
+
+// fakeRoute handles a request that is not plain CRUD. The fake is locked when it runs.
+type fakeRoute func(fake *Fake, req *http.Request, body []byte) (int, interface{})
+
+// collection is one kind of stored object.
+type collection struct {
+	items  map[int64]FakeObject
+	nextID int64
+	// filters maps query parameters to the object fields they filter on.
+	filters map[string]string
+	// paged collections return a page of records instead of a list.
+	paged bool
+}
+
+// NewFakeSonarr returns a running fake Sonarr with series, episodes, tags,
+// quality profiles, the queue and commands. Use it with sonarr.New(starr.New(apiKey, fake.URL, 0)).
+func NewFakeSonarr(apiKey string) *Fake {
+	fake := newFake(apiKey, "Sonarr", "/api/v3/")
+	fake.addCollection("series", false, map[string]string{"tvdbId": "tvdbId"})
+	fake.addCollection("episode", false, map[string]string{
+		"seriesId":      "seriesId",
+		"seasonNumber":  "seasonNumber",
+		"episodeIds":    "id",
+		"episodeFileId": "episodeFileId",
+	})
+	fake.addCollection("tag", false, nil)
+	fake.addCollection("qualityprofile", false, nil)
+	fake.addCollection("queue", true, nil)
+	fake.addCollection("command", false, nil)
+	fake.routes[http.MethodPut+" episode/monitor"] = monitorEpisodes
+
+	return fake
+}
+
+// NewFakeRadarr returns a running fake Radarr with movies, tags, quality profiles,
+// the queue and commands. Use it with radarr.New(starr.New(apiKey, fake.URL, 0)).
+func NewFakeRadarr(apiKey string) *Fake {
+	fake := newFake(apiKey, "Radarr", "/api/v3/")
+	fake.addCollection("movie", false, map[string]string{"tmdbId": "tmdbId"})
+	fake.addCollection("tag", false, nil)
+	fake.addCollection("qualityprofile", false, nil)
+	fake.addCollection("queue", true, nil)
+	fake.addCollection("command", false, nil)
+
+	return fake
+}
+
+func newFake(apiKey, appName, prefix string) *Fake {
+	fake := &Fake{
+		APIKey:      apiKey,
+		AppName:     appName,
+		Version:     DefaultFakeVersion,
+		prefix:      prefix,
+		collections: make(map[string]*collection),
+		routes:      make(map[string]fakeRoute),
+	}
+
+	fake.routes[http.MethodGet+" system/status"] = systemStatus
+	fake.routes[http.MethodPost+" command"] = sendCommand
+	fake.Server = httptest.NewServer(fake)
+
+	return fake
+}
+
+func (f *Fake) addCollection(name string, paged bool, filters map[string]string) {
+	f.collections[name] = &collection{items: make(map[int64]FakeObject), filters: filters, paged: paged}
+}
+
+// Add stores a copy of object in a collection, and returns its new ID. The object is converted through JSON,
+// and any ID it has is replaced.
+// Use it to seed the fake with data, like fake.Add("series", &sonarr.Series{Title: "Show"}).
+func (f *Fake) Add(name string, object interface{}) (int64, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return 0, fmt.Errorf("json.Marshal(%s): %w", name, err)
+	}
+
+	var obj FakeObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return 0, fmt.Errorf("json.Unmarshal(%s): %w", name, err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	coll := f.collections[strings.ToLower(name)]
+	if coll == nil {
+		return 0, fmt.Errorf("%w: %s", ErrNoCollection, name)
+	}
+
+	return coll.add(obj), nil
+}
+
+// Get returns a copy of a stored object, or nil if it does not exist.
+func (f *Fake) Get(name string, id int64) FakeObject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if coll := f.collections[strings.ToLower(name)]; coll != nil && coll.items[id] != nil {
+		return copyObject(coll.items[id])
+	}
+
+	return nil
+}
+
+// List returns copies of every object in a collection, ordered by ID.
+func (f *Fake) List(name string) []FakeObject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	coll := f.collections[strings.ToLower(name)]
+	if coll == nil {
+		return nil
+	}
+
+	return coll.list(nil)
+}
+
+// ServeHTTP makes Fake an http.Handler.
+func (f *Fake) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/ping" { // ping has no api prefix, and no auth.
+		writeJSON(writer, http.StatusOK, FakeObject{"status": "OK"})
+		return
+	}
+
+	if req.Header.Get("X-Api-Key") != f.APIKey {
+		writer.WriteHeader(http.StatusUnauthorized)
+		_, _ = writer.Write([]byte(BodyUnauthorized))
+
+		return
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		writeJSON(writer, http.StatusBadRequest, FakeObject{"message": err.Error()})
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	uri := strings.Trim(strings.TrimPrefix(strings.ToLower(req.URL.Path), f.prefix), "/")
+	if route := f.routes[req.Method+" "+uri]; route != nil {
+		code, output := route(f, req, body)
+		writeJSON(writer, code, output)
+
+		return
+	}
+
+	code, output := f.crud(req, uri, body)
+	writeJSON(writer, code, output)
+}
+
+// crud handles list, get, create, update and delete for a collection.
+func (f *Fake) crud(req *http.Request, uri string, body []byte) (int, interface{}) {
+	name, idStr, hasID := strings.Cut(uri, "/")
+	coll := f.collections[name]
+
+	if coll == nil {
+		return http.StatusNotFound, FakeObject{"message": "NotFound"}
+	}
+
+	var id int64
+
+	if hasID {
+		var err error
+		if id, err = strconv.ParseInt(idStr, 10, 64); err != nil {
+			return http.StatusNotFound, FakeObject{"message": "NotFound"}
+		}
+	}
+
+	switch {
+	case req.Method == http.MethodGet && !hasID && coll.paged:
+		return http.StatusOK, coll.page(req.URL.Query())
+	case req.Method == http.MethodGet && !hasID:
+		return http.StatusOK, coll.list(req.URL.Query())
+	case req.Method == http.MethodPost && !hasID:
+		obj, err := decodeObject(body)
+		if err != nil {
+			return http.StatusBadRequest, FakeObject{"message": err.Error()}
+		}
+
+		return http.StatusCreated, copyObject(coll.items[coll.add(obj)])
+	}
+
+	if coll.items[id] == nil {
+		return http.StatusNotFound, FakeObject{"message": "NotFound"}
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		return http.StatusOK, copyObject(coll.items[id])
+	case http.MethodPut:
+		obj, err := decodeObject(body)
+		if err != nil {
+			return http.StatusBadRequest, FakeObject{"message": err.Error()}
+		}
+
+		obj["id"], coll.items[id] = float64(id), obj
+
+		return http.StatusAccepted, copyObject(obj)
+	case http.MethodDelete:
+		delete(coll.items, id)
+		return http.StatusOK, nil
+	default:
+		return http.StatusMethodNotAllowed, FakeObject{"message": "MethodNotAllowed"}
+	}
+}
+
+func (c *collection) add(obj FakeObject) int64 {
+	c.nextID++
+	obj["id"] = float64(c.nextID)
+	c.items[c.nextID] = obj
+
+	return c.nextID
+}
+
+// list returns the objects that match the query's filters, ordered by ID.
+func (c *collection) list(query url.Values) []FakeObject {
+	ids := make([]int64, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	output := []FakeObject{}
+
+	for _, id := range ids {
+		if c.matches(c.items[id], query) {
+			output = append(output, copyObject(c.items[id]))
+		}
+	}
+
+	return output
+}
+
+func (c *collection) matches(obj FakeObject, query url.Values) bool {
+	for param, field := range c.filters {
+		wanted, ok := query[param]
+		if !ok {
+			continue
+		}
+
+		value := fmt.Sprint(obj[field])
+		if num, ok := obj[field].(float64); ok {
+			value = strconv.FormatFloat(num, 'f', -1, 64)
+		}
+
+		found := false
+
+		for _, want := range wanted {
+			found = found || strings.EqualFold(want, value)
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// page returns one page of records, like the paged endpoints do.
+func (c *collection) page(query url.Values) FakeObject {
+	records := c.list(query)
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	size, _ := strconv.Atoi(query.Get("pageSize"))
+	if size < 1 {
+		size = DefaultFakePageSize
+	}
+
+	start, end := (page-1)*size, page*size
+	if start > len(records) {
+		start = len(records)
+	}
+
+	if end > len(records) {
+		end = len(records)
+	}
+
+	return FakeObject{
+		"page":          page,
+		"pageSize":      size,
+		"sortKey":       query.Get("sortKey"),
+		"sortDirection": query.Get("sortDirection"),
+		"totalRecords":  len(records),
+		"records":       records[start:end],
+	}
+}
+
+func systemStatus(fake *Fake, _ *http.Request, _ []byte) (int, interface{}) {
+	return http.StatusOK, FakeObject{"appName": fake.AppName, "instanceName": fake.AppName, "version": fake.Version}
+}
+
+// sendCommand stores a command as completed, so code that waits for it does not wait.
+func sendCommand(fake *Fake, _ *http.Request, body []byte) (int, interface{}) {
+	obj, err := decodeObject(body)
+	if err != nil {
+		return http.StatusBadRequest, FakeObject{"message": err.Error()}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	command := FakeObject{
+		"name":        obj["name"],
+		"commandName": obj["name"],
+		"status":      "completed",
+		"result":      "successful",
+		"trigger":     "manual",
+		"queued":      now,
+		"started":     now,
+		"ended":       now,
+		"body":        obj,
+	}
+
+	coll := fake.collections["command"]
+
+	return http.StatusCreated, copyObject(coll.items[coll.add(command)])
+}
+
+// monitorEpisodes sets monitored on a list of episodes.
+func monitorEpisodes(fake *Fake, _ *http.Request, body []byte) (int, interface{}) {
+	var input struct {
+		EpisodeIDs []int64 `json:"episodeIds"`
+		Monitored  bool    `json:"monitored"`
+	}
+
+	if err := json.Unmarshal(body, &input); err != nil {
+		return http.StatusBadRequest, FakeObject{"message": err.Error()}
+	}
+
+	coll := fake.collections["episode"]
+	output := []FakeObject{}
+
+	for _, id := range input.EpisodeIDs {
+		if episode := coll.items[id]; episode != nil {
+			episode["monitored"] = input.Monitored
+			output = append(output, copyObject(episode))
+		}
+	}
+
+	return http.StatusAccepted, output
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading body: %w", err)
+	}
+
+	return body, nil
+}
+
+func decodeObject(body []byte) (FakeObject, error) {
+	var obj FakeObject
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil, fmt.Errorf("decoding body: %w", err)
+	} else if obj == nil {
+		return nil, ErrNoBody
+	}
+
+	return obj, nil
+}
+
+// copyObject returns a deep copy of obj, so callers cannot change stored objects.
+func copyObject(obj FakeObject) FakeObject {
+	data, _ := json.Marshal(obj)
+
+	var output FakeObject
+	_ = json.Unmarshal(data, &output)
+
+	return output
+}
+
+func writeJSON(writer http.ResponseWriter, code int, output interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(code)
+
+	if output != nil {
+		_ = json.NewEncoder(writer).Encode(output)
+	}
+}
@@ -0,0 +1,101 @@
+package starrtest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BSFishy/starr"
+	"github.com/BSFishy/starr/radarr"
+	"github.com/BSFishy/starr/sonarr"
+	"github.com/BSFishy/starr/starrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeSonarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeSonarr("key")
+	defer fake.Close()
+
+	client := sonarr.New(starr.New("key", fake.URL, 0))
+
+	tag, err := client.AddTag(&starr.Tag{Label: "anime"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, tag.ID)
+
+	series, err := client.AddSeries(&sonarr.AddSeriesInput{Title: "Show", TvdbID: 123, Tags: []int{tag.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), series.ID)
+	_, err = client.AddSeries(&sonarr.AddSeriesInput{Title: "Other", TvdbID: 456})
+	require.NoError(t, err)
+
+	found, err := client.GetSeries(123)
+	require.NoError(t, err)
+	require.Len(t, found, 1, "series must be filtered by tvdb ID")
+	assert.Equal(t, "Show", found[0].Title)
+
+	for _, episode := range []*sonarr.Episode{{SeriesID: 1, EpisodeNumber: 1}, {SeriesID: 2, EpisodeNumber: 1}} {
+		_, err = fake.Add("episode", episode)
+		require.NoError(t, err)
+	}
+
+	episodes, err := client.GetSeriesEpisodes(&sonarr.GetEpisode{SeriesID: 1})
+	require.NoError(t, err)
+	require.Len(t, episodes, 1)
+
+	_, err = client.MonitorEpisode([]int64{episodes[0].ID}, true)
+	require.NoError(t, err)
+	assert.Equal(t, true, fake.Get("episode", episodes[0].ID)["monitored"], "monitoring must be stored")
+
+	for i := 0; i < 3; i++ {
+		_, err = fake.Add("queue", &sonarr.QueueRecord{SeriesID: 1})
+		require.NoError(t, err)
+	}
+
+	queue, err := client.GetQueue(0, 2)
+	require.NoError(t, err)
+	assert.Len(t, queue.Records, 3, "every page must be returned")
+	assert.Equal(t, 3, queue.TotalRecords)
+
+	command, err := client.SendCommand(&sonarr.CommandRequest{Name: "RefreshSeries", SeriesID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "completed", command.Status)
+	assert.Len(t, fake.List("command"), 1)
+
+	require.NoError(t, client.DeleteSeries(1, false, false))
+	_, err = client.GetSeriesByID(1)
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusNotFound})
+
+	_, err = sonarr.New(starr.New("wrong", fake.URL, 0)).GetTags()
+	require.ErrorIs(t, err, &starr.ReqError{Code: http.StatusUnauthorized})
+}
+
+func TestFakeRadarr(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewFakeRadarr("key")
+	defer fake.Close()
+
+	client := radarr.New(starr.New("key", fake.URL, 0))
+
+	status, err := client.GetSystemStatus()
+	require.NoError(t, err)
+	assert.Equal(t, "Radarr", status.AppName)
+
+	movie, err := client.AddMovie(&radarr.AddMovieInput{Title: "Film", TmdbID: 603, QualityProfileID: 1})
+	require.NoError(t, err)
+
+	movie.Monitored = true
+	_, err = client.UpdateMovie(movie.ID, movie, false)
+	require.NoError(t, err)
+
+	movies, err := client.GetMovie(&radarr.GetMovie{TMDBID: 603})
+	require.NoError(t, err)
+	require.Len(t, movies, 1)
+	assert.True(t, movies[0].Monitored, "updates must be stored")
+
+	movies, err = client.GetMovie(&radarr.GetMovie{TMDBID: 604})
+	require.NoError(t, err)
+	assert.Empty(t, movies)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,18 +48,58 @@ func (test *MockData) GetMockServer(t *testing.T) *httptest.Server {
 	t.Helper()
 
 	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
-		assert.EqualValues(t, test.ExpectedPath, req.URL.String(),
-			"test.ExpectedPath does not match the actual path")
-		writer.WriteHeader(test.ResponseStatus)
-		assert.EqualValues(t, test.ExpectedMethod, req.Method,
-			"test.ExpectedMethod does not match the actual method")
-
-		body, err := io.ReadAll(req.Body)
-		assert.NoError(t, err)
-		assert.EqualValues(t, test.ExpectedRequest, string(body),
-			"test.ExpectedRequest does not match body for actual request")
-
-		_, err = writer.Write([]byte(test.ResponseBody))
-		assert.NoError(t, err)
+		test.serve(t, writer, req)
 	}))
 }
+
+// GetMockSequence is GetMockServer for methods that make more than one request. Each request is
+// checked against, and answered by, the next test in the list. The server is closed when the test
+// ends, and the test fails if any of the requests were not made.
+func GetMockSequence(t *testing.T, tests ...*MockData) *httptest.Server {
+	t.Helper()
+
+	var (
+		mu    sync.Mutex
+		count int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if count >= len(tests) {
+			t.Errorf("unexpected request %d: %s %s", count+1, req.Method, req.URL)
+			writer.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		count++
+		tests[count-1].serve(t, writer, req)
+	}))
+
+	t.Cleanup(func() {
+		server.Close()
+		assert.Equal(t, len(tests), count, "every request in the sequence must be made")
+	})
+
+	return server
+}
+
+func (test *MockData) serve(t *testing.T, writer http.ResponseWriter, req *http.Request) {
+	t.Helper()
+
+	assert.EqualValues(t, test.ExpectedPath, req.URL.String(),
+		"test.ExpectedPath does not match the actual path")
+	writer.WriteHeader(test.ResponseStatus)
+	assert.EqualValues(t, test.ExpectedMethod, req.Method,
+		"test.ExpectedMethod does not match the actual method")
+
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.EqualValues(t, test.ExpectedRequest, string(body),
+		"test.ExpectedRequest does not match body for actual request")
+
+	_, err = writer.Write([]byte(test.ResponseBody))
+	assert.NoError(t, err)
+}